@@ -0,0 +1,11 @@
+package converter
+
+const (
+	// ErrorDomain is the domain of the ErrorInfo details attached to the
+	// status errors returned by the agent.
+	ErrorDomain = "yorkie"
+
+	// ReasonDocumentRemoved is the reason of the ErrorInfo details attached
+	// to the status error of a removed document.
+	ReasonDocumentRemoved = "DOCUMENT_REMOVED"
+)
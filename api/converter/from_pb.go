@@ -1,8 +1,11 @@
 package converter
 
 import (
+	"bytes"
 	"errors"
 
+	"github.com/golang/protobuf/jsonpb"
+
 	"github.com/hackerwins/yorkie/api"
 	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/document/checkpoint"
@@ -17,6 +20,7 @@ import (
 var (
 	errPackRequired       = errors.New("pack required")
 	errCheckpointRequired = errors.New("checkpoint required")
+	errInvalidSnapshot    = errors.New("invalid snapshot")
 )
 
 // TODO There is no guarantee that the message sent by the client is perfect.
@@ -32,13 +36,29 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 	}
 
 	return &change.Pack{
-		DocumentKey: fromDocumentKey(pbPack.DocumentKey),
+		DocumentKey: FromDocumentKey(pbPack.DocumentKey),
 		Checkpoint:  fromCheckpoint(pbPack.Checkpoint),
-		Changes:     fromChanges(pbPack.Changes),
+		Changes:     FromChanges(pbPack.Changes),
+		Snapshot:    pbPack.Snapshot,
 	}, nil
 }
 
-func fromDocumentKey(pbKey *api.DocumentKey) *key.Key {
+// FromChangePacks converts the given Protobuf format to model format.
+func FromChangePacks(pbPacks []*api.ChangePack) ([]*change.Pack, error) {
+	var packs []*change.Pack
+	for _, pbPack := range pbPacks {
+		pack, err := FromChangePack(pbPack)
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, pack)
+	}
+
+	return packs, nil
+}
+
+// FromDocumentKey converts the given Protobuf format to model format.
+func FromDocumentKey(pbKey *api.DocumentKey) *key.Key {
 	return &key.Key{
 		Collection: pbKey.Collection,
 		Document:   pbKey.Document,
@@ -52,14 +72,19 @@ func fromCheckpoint(pbCheckpoint *api.Checkpoint) *checkpoint.Checkpoint {
 	)
 }
 
-func fromChanges(pbChanges []*api.Change) []*change.Change {
+// FromChanges converts the given Protobuf format to model format.
+func FromChanges(pbChanges []*api.Change) []*change.Change {
 	var changes []*change.Change
 	for _, pbChange := range pbChanges {
-		changes = append(changes, change.New(
+		c := change.New(
 			fromChangeID(pbChange.Id),
 			pbChange.Message,
 			FromOperations(pbChange.Operations),
-		))
+		)
+		if pbChange.ServerSeq > 0 {
+			c.SetServerSeq(pbChange.ServerSeq)
+		}
+		changes = append(changes, c)
 	}
 
 	return changes
@@ -99,6 +124,13 @@ func FromOperations(pbOps []*api.Operation) []operation.Operation {
 				fromTimeTicket(decoded.Remove.CreatedAt),
 				fromTimeTicket(decoded.Remove.ExecutedAt),
 			)
+		case *api.Operation_Move_:
+			op = operation.NewMove(
+				fromTimeTicket(decoded.Move.ParentCreatedAt),
+				fromTimeTicket(decoded.Move.PrevCreatedAt),
+				fromTimeTicket(decoded.Move.CreatedAt),
+				fromTimeTicket(decoded.Move.ExecutedAt),
+			)
 		case *api.Operation_Edit_:
 			op = operation.NewEdit(
 				fromTimeTicket(decoded.Edit.ParentCreatedAt),
@@ -154,6 +186,11 @@ func fromElement(pbElement *api.JSONElement) datatype.Element {
 			datatype.NewRGA(),
 			fromTimeTicket(pbElement.CreatedAt),
 		)
+	case api.ValueType_NULL:
+		return datatype.NewPrimitive(
+			datatype.ValueFromBytes(datatype.Null, pbElement.Value),
+			fromTimeTicket(pbElement.CreatedAt),
+		)
 	case api.ValueType_BOOLEAN:
 		return datatype.NewPrimitive(
 			datatype.ValueFromBytes(datatype.Boolean, pbElement.Value),
@@ -198,3 +235,95 @@ func fromElement(pbElement *api.JSONElement) datatype.Element {
 
 	panic("fail to decode element")
 }
+
+// BytesToSnapshot decodes the given snapshot into the root object and the
+// lamport clock.
+func BytesToSnapshot(snapshot []byte) (*json.Object, uint64, error) {
+	pbSnapshot := &api.Snapshot{}
+	if err := pbSnapshot.Unmarshal(snapshot); err != nil {
+		log.Logger.Error(err)
+		return nil, 0, err
+	}
+
+	obj, ok := fromSnapshotElement(pbSnapshot.Root).(*json.Object)
+	if !ok {
+		log.Logger.Error(errInvalidSnapshot)
+		return nil, 0, errInvalidSnapshot
+	}
+
+	return obj, pbSnapshot.Lamport, nil
+}
+
+func fromSnapshotElement(pbElement *api.SnapshotElement) datatype.Element {
+	switch body := pbElement.Body.(type) {
+	case *api.SnapshotElement_Object_:
+		obj := json.NewObject(datatype.NewRHT(), fromTimeTicket(body.Object.CreatedAt))
+		for _, pbNode := range body.Object.Nodes {
+			elem := fromSnapshotElement(pbNode.Element)
+			obj.Set(pbNode.Key, elem)
+			if pbNode.IsRemoved {
+				obj.RemoveByCreatedAt(elem.CreatedAt())
+			}
+		}
+		return obj
+	case *api.SnapshotElement_Array_:
+		arr := json.NewArray(datatype.NewRGA(), fromTimeTicket(body.Array.CreatedAt))
+		for _, pbNode := range body.Array.Nodes {
+			if pbNode.Element == nil {
+				arr.AddNode(fromTimeTicket(pbNode.PositionedAt), nil)
+				continue
+			}
+
+			elem := fromSnapshotElement(pbNode.Element)
+			if pbNode.PositionedAt != nil {
+				arr.AddNode(fromTimeTicket(pbNode.PositionedAt), elem)
+			} else {
+				arr.Add(elem)
+			}
+			if pbNode.IsRemoved {
+				arr.RemoveByCreatedAt(elem.CreatedAt())
+			}
+		}
+		return arr
+	case *api.SnapshotElement_Primitive:
+		return fromElement(body.Primitive)
+	case *api.SnapshotElement_Text_:
+		rgaTreeSplit := datatype.NewRGATreeSplit()
+		current := rgaTreeSplit.InitialHead()
+		for _, pbNode := range body.Text.Nodes {
+			var deletedAt *time.Ticket
+			if pbNode.DeletedAt != nil {
+				deletedAt = fromTimeTicket(pbNode.DeletedAt)
+			}
+			current = rgaTreeSplit.InsertAfter(current, datatype.NewTextNode(
+				fromTextNodeID(pbNode.Id),
+				pbNode.Value,
+				deletedAt,
+			))
+			if pbNode.InsPrevId != nil {
+				current.SetInsPrev(rgaTreeSplit.FindTextNode(fromTextNodeID(pbNode.InsPrevId)))
+			}
+		}
+		return datatype.NewText(rgaTreeSplit, fromTimeTicket(body.Text.CreatedAt))
+	}
+
+	panic("fail to decode snapshot element")
+}
+
+func fromTextNodeID(pbID *api.TextNodeID) *datatype.TextNodeID {
+	return datatype.NewTextNodeID(
+		fromTimeTicket(pbID.CreatedAt),
+		int(pbID.Offset),
+	)
+}
+
+// JSONToDocumentExport decodes the given JSON into an export.
+func JSONToDocumentExport(data []byte) (*api.DocumentExport, error) {
+	export := &api.DocumentExport{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), export); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return export, nil
+}
@@ -1,6 +1,10 @@
 package converter
 
 import (
+	"bytes"
+
+	"github.com/golang/protobuf/jsonpb"
+
 	"github.com/hackerwins/yorkie/api"
 	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/document/checkpoint"
@@ -9,17 +13,30 @@ import (
 	"github.com/hackerwins/yorkie/pkg/document/key"
 	"github.com/hackerwins/yorkie/pkg/document/operation"
 	"github.com/hackerwins/yorkie/pkg/document/time"
+	"github.com/hackerwins/yorkie/pkg/log"
 )
 
 func ToChangePack(pack *change.Pack) *api.ChangePack {
 	return &api.ChangePack{
-		DocumentKey: toDocumentKey(pack.DocumentKey),
+		DocumentKey: ToDocumentKey(pack.DocumentKey),
 		Checkpoint:  toCheckpoint(pack.Checkpoint),
-		Changes:     toChanges(pack.Changes),
+		Changes:     ToChanges(pack.Changes),
+		Snapshot:    pack.Snapshot,
 	}
 }
 
-func toDocumentKey(key *key.Key) *api.DocumentKey {
+// ToChangePacks converts the given model format to Protobuf format.
+func ToChangePacks(packs []*change.Pack) []*api.ChangePack {
+	var pbPacks []*api.ChangePack
+	for _, pack := range packs {
+		pbPacks = append(pbPacks, ToChangePack(pack))
+	}
+
+	return pbPacks
+}
+
+// ToDocumentKey converts the given model format to Protobuf format.
+func ToDocumentKey(key *key.Key) *api.DocumentKey {
 	return &api.DocumentKey{
 		Collection: key.Collection,
 		Document:   key.Document,
@@ -33,13 +50,15 @@ func toCheckpoint(cp *checkpoint.Checkpoint) *api.Checkpoint {
 	}
 }
 
-func toChanges(changes []*change.Change) []*api.Change {
+// ToChanges converts the given model format to Protobuf format.
+func ToChanges(changes []*change.Change) []*api.Change {
 	var pbChanges []*api.Change
 	for _, c := range changes {
 		pbChanges = append(pbChanges, &api.Change{
 			Id:         toChangeID(c.ID()),
 			Message:    c.Message(),
 			Operations: ToOperations(c.Operations()),
+			ServerSeq:  c.ServerSeq(),
 		})
 	}
 
@@ -86,6 +105,15 @@ func ToOperations(operations []operation.Operation) []*api.Operation {
 					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
 				},
 			}
+		case *operation.Move:
+			pbOperation.Body = &api.Operation_Move_{
+				Move: &api.Operation_Move{
+					ParentCreatedAt: toTimeTicket(op.ParentCreatedAt()),
+					PrevCreatedAt:   toTimeTicket(op.PrevCreatedAt()),
+					CreatedAt:       toTimeTicket(op.CreatedAt()),
+					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
+				},
+			}
 		case *operation.Edit:
 			pbOperation.Body = &api.Operation_Edit_{
 				Edit: &api.Operation_Edit{
@@ -120,6 +148,12 @@ func toJSONElement(elem datatype.Element) *api.JSONElement {
 		}
 	case *datatype.Primitive:
 		switch elem.ValueType() {
+		case datatype.Null:
+			return &api.JSONElement{
+				Type:      api.ValueType_NULL,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Value:     elem.Bytes(),
+			}
 		case datatype.Boolean:
 			return &api.JSONElement{
 				Type:      api.ValueType_BOOLEAN,
@@ -197,3 +231,105 @@ func toTimeTicket(ticket *time.Ticket) *api.TimeTicket {
 		ActorId:   ticket.ActorIDHex(),
 	}
 }
+
+// SnapshotToBytes encodes the given root object and lamport clock into a
+// snapshot which keeps the metadata of CRDT.
+func SnapshotToBytes(obj *json.Object, lamport uint64) ([]byte, error) {
+	pbSnapshot := &api.Snapshot{
+		Root:    toSnapshotElement(obj),
+		Lamport: lamport,
+	}
+
+	bytes, err := pbSnapshot.Marshal()
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
+func toSnapshotElement(elem datatype.Element) *api.SnapshotElement {
+	switch elem := elem.(type) {
+	case *json.Object:
+		var pbNodes []*api.RHTNode
+		for _, node := range elem.AllNodes() {
+			pbNodes = append(pbNodes, &api.RHTNode{
+				Key:       node.Key(),
+				Element:   toSnapshotElement(node.Element()),
+				IsRemoved: node.IsRemoved(),
+			})
+		}
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Object_{Object: &api.SnapshotElement_Object{
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Nodes:     pbNodes,
+			}},
+		}
+	case *json.Array:
+		var pbNodes []*api.RGANode
+		for _, node := range elem.AllNodes() {
+			pbNode := &api.RGANode{IsRemoved: node.IsRemoved()}
+			if node.Element() != nil {
+				pbNode.Element = toSnapshotElement(node.Element())
+			}
+			if node.Element() == nil ||
+				node.PositionedAt().Compare(node.Element().CreatedAt()) != 0 {
+				pbNode.PositionedAt = toTimeTicket(node.PositionedAt())
+			}
+			pbNodes = append(pbNodes, pbNode)
+		}
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Array_{Array: &api.SnapshotElement_Array{
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Nodes:     pbNodes,
+			}},
+		}
+	case *datatype.Primitive:
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Primitive{Primitive: toJSONElement(elem)},
+		}
+	case *datatype.Text:
+		var pbNodes []*api.TextNode
+		for _, node := range elem.TextNodes() {
+			pbNode := &api.TextNode{
+				Id:    toTextNodeID(node.ID()),
+				Value: node.String(),
+			}
+			if node.DeletedAt() != nil {
+				pbNode.DeletedAt = toTimeTicket(node.DeletedAt())
+			}
+			if node.InsPrevID() != nil {
+				pbNode.InsPrevId = toTextNodeID(node.InsPrevID())
+			}
+			pbNodes = append(pbNodes, pbNode)
+		}
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Text_{Text: &api.SnapshotElement_Text{
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Nodes:     pbNodes,
+			}},
+		}
+	}
+
+	panic("fail to encode element to snapshot")
+}
+
+func toTextNodeID(id *datatype.TextNodeID) *api.TextNodeID {
+	return &api.TextNodeID{
+		CreatedAt: toTimeTicket(id.CreatedAt()),
+		Offset:    int32(id.Offset()),
+	}
+}
+
+// DocumentExportToJSON encodes the given export into JSON.
+func DocumentExportToJSON(export *api.DocumentExport) ([]byte, error) {
+	var buf bytes.Buffer
+	marshaler := jsonpb.Marshaler{Indent: "  "}
+	if err := marshaler.Marshal(&buf, export); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/hackerwins/yorkie/api"
+	"github.com/hackerwins/yorkie/api/converter"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
+)
+
+// AdminClient is a client for operators to manage clients and documents in
+// the agent through the Admin service.
+type AdminClient struct {
+	conn   *grpc.ClientConn
+	client api.AdminClient
+}
+
+// NewAdminClient creates an instance of AdminClient that calls the agent with
+// the given admin token.
+func NewAdminClient(rpcAddr string, adminToken string) (*AdminClient, error) {
+	conn, err := grpc.Dial(
+		rpcAddr,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(trace.UnaryClientInterceptor()),
+		grpc.WithPerRPCCredentials(adminTokenCredential(adminToken)),
+	)
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return &AdminClient{
+		conn:   conn,
+		client: api.NewAdminClient(conn),
+	}, nil
+}
+
+// Close closes the connection to the agent.
+func (c *AdminClient) Close() error {
+	if err := c.conn.Close(); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// DeactivateClient deactivates the client of the given ID after detaching all
+// the documents attached to it.
+func (c *AdminClient) DeactivateClient(ctx context.Context, clientID string) (*ClientInfo, error) {
+	res, err := c.client.DeactivateClient(ctx, &api.AdminDeactivateClientRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return fromPBClientInfo(res.ClientInfo)
+}
+
+// DetachDocument detaches the document of the given key from the client of
+// the given ID.
+func (c *AdminClient) DetachDocument(
+	ctx context.Context,
+	clientID string,
+	k *key.Key,
+) (*ClientInfo, error) {
+	res, err := c.client.DetachDocument(ctx, &api.AdminDetachDocumentRequest{
+		ClientId:    clientID,
+		DocumentKey: converter.ToDocumentKey(k),
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return fromPBClientInfo(res.ClientInfo)
+}
+
+// RemoveDocument removes the document of the given key with its changes.
+func (c *AdminClient) RemoveDocument(ctx context.Context, k *key.Key) (*DocumentInfo, error) {
+	res, err := c.client.RemoveDocument(ctx, &api.AdminRemoveDocumentRequest{
+		DocumentKey: converter.ToDocumentKey(k),
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return fromPBDocumentInfo(res.DocumentInfo)
+}
+
+// adminTokenCredential attaches the admin token to the metadata of each call.
+type adminTokenCredential string
+
+func (t adminTokenCredential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": string(t)}, nil
+}
+
+func (t adminTokenCredential) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hackerwins/yorkie/client"
+	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/pkg/document/proxy"
+	"github.com/hackerwins/yorkie/testhelper"
+	"github.com/hackerwins/yorkie/yorkie"
+	"github.com/hackerwins/yorkie/yorkie/types"
+)
+
+func TestAdminClient(t *testing.T) {
+	withYorkieAndTwoClients(t, func(t *testing.T, r *yorkie.Yorkie, c1 *client.Client, c2 *client.Client) {
+		admin, err := client.NewAdminClient(testRPCAddr, testhelper.TestAdminToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := admin.Close(); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		t.Run("invalid admin token test", func(t *testing.T) {
+			ctx := context.Background()
+			cli, err := client.NewAdminClient(testRPCAddr, "invalid-token")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := cli.Close(); err != nil {
+					t.Error(err)
+				}
+			}()
+
+			_, err = cli.DeactivateClient(ctx, c1.ID().String())
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+
+		t.Run("detach document test", func(t *testing.T) {
+			ctx := context.Background()
+			doc := document.New(testCollection, t.Name())
+			if err := c1.AttachDocument(ctx, doc); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := admin.DetachDocument(ctx, c1.ID().String(), doc.Key())
+			assert.NoError(t, err)
+			assert.Error(t, c1.PushPull(ctx))
+
+			_, err = admin.DetachDocument(ctx, c1.ID().String(), doc.Key())
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+
+		t.Run("remove document test", func(t *testing.T) {
+			ctx := context.Background()
+			doc := document.New(testCollection, t.Name())
+			if err := doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if err := c2.AttachDocument(ctx, doc); err != nil {
+				t.Fatal(err)
+			}
+
+			docInfo, err := admin.RemoveDocument(ctx, doc.Key())
+			assert.NoError(t, err)
+			assert.Equal(t, doc.Key().BSONKey(), docInfo.Key)
+
+			_, err = c2.ListChanges(ctx, doc.Key(), 0, 0, 0)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			_, err = admin.RemoveDocument(ctx, doc.Key())
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run("deactivate client test", func(t *testing.T) {
+			ctx := context.Background()
+			doc := document.New(testCollection, t.Name())
+			if err := c2.AttachDocument(ctx, doc); err != nil {
+				t.Fatal(err)
+			}
+
+			clientInfo, err := admin.DeactivateClient(ctx, c2.ID().String())
+			assert.NoError(t, err)
+			assert.Equal(t, types.ClientDeactivated, clientInfo.Status)
+			for _, docInfo := range clientInfo.Documents {
+				assert.Equal(t, types.DocumentDetached, docInfo.Status)
+			}
+			assert.Error(t, c2.PushPull(ctx))
+		})
+	})
+}
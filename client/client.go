@@ -3,15 +3,24 @@ package client
 import (
 	"context"
 	"errors"
+	time2 "time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/hackerwins/yorkie/api"
 	"github.com/hackerwins/yorkie/api/converter"
 	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/pkg/document/change"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/document/schema"
 	"github.com/hackerwins/yorkie/pkg/document/time"
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
 )
 
 type status int
@@ -21,11 +30,91 @@ const (
 	activated   status = 1
 )
 
+const (
+	// DefaultRenewalInterval is the default interval at which a client renews
+	// its lease on the agent.
+	DefaultRenewalInterval = 10 * time2.Second
+)
+
+// AttachMode decides how to attach a document depending on whether it exists
+// in the agent or not.
+type AttachMode int
+
+const (
+	// AttachOrCreate attaches the document, creating it if it does not exist.
+	AttachOrCreate AttachMode = iota
+
+	// CreateOrFail creates the document and attaches it. It fails if the
+	// document already exists.
+	CreateOrFail
+
+	// AttachExisting attaches the document only if it exists.
+	AttachExisting
+)
+
+// SyncMode decides how an attached document is synchronized by PushPull.
+type SyncMode int
+
+const (
+	// SyncRealtime pushes and pulls the changes of the document whenever
+	// PushPull is called.
+	SyncRealtime SyncMode = iota
+
+	// SyncManual pushes and pulls the changes of the document only when the
+	// document is given to PushPull explicitly.
+	SyncManual
+
+	// SyncPushOnly only pushes local changes of the document. Remote changes
+	// are pulled after the mode is changed.
+	SyncPushOnly
+
+	// SyncPullOnly only pulls remote changes of the document. Local changes
+	// are kept and pushed after the mode is changed.
+	SyncPullOnly
+)
+
 var (
 	errClientNotActivated  = errors.New("client is not activated")
 	errDocumentNotAttached = errors.New("document is not attached")
 )
 
+// DocumentError is the error of a document that failed in a request for
+// several documents. The other documents of the request are processed
+// regardless of it. The gRPC status of the error can be taken with
+// status.Convert.
+type DocumentError struct {
+	Key    *key.Key
+	status *grpcstatus.Status
+}
+
+// fromDocumentError converts the given Protobuf DocumentError to
+// DocumentError.
+func fromDocumentError(pbErr *api.DocumentError) *DocumentError {
+	st := grpcstatus.New(codes.Code(pbErr.Code), pbErr.Message)
+	if pbErr.Reason != "" {
+		if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+			Domain: converter.ErrorDomain,
+			Reason: pbErr.Reason,
+		}); err == nil {
+			st = detailed
+		}
+	}
+
+	return &DocumentError{
+		Key:    converter.FromDocumentKey(pbErr.DocumentKey),
+		status: st,
+	}
+}
+
+func (e *DocumentError) Error() string {
+	return e.Key.BSONKey() + ": " + e.status.Message()
+}
+
+// GRPCStatus returns the gRPC status of the error.
+func (e *DocumentError) GRPCStatus() *grpcstatus.Status {
+	return e.status
+}
+
 // Client is a normal client that can communicate with the agent.
 // It has documents and sends changes of the document in local
 // to the agent to synchronize with other replicas in remote.
@@ -37,18 +126,61 @@ type Client struct {
 	key          string
 	status       status
 	attachedDocs map[string]*document.Document
+	syncModes    map[string]SyncMode
+
+	renewalInterval time2.Duration
+	stopRenewal     context.CancelFunc
+}
+
+// Option configures Client.
+type Option struct {
+	// Key is the key of the client. If it is empty, a random UUID is used.
+	Key string
+
+	// RenewalInterval is the interval at which the client renews its lease
+	// on the agent while activated. If it is 0, DefaultRenewalInterval is
+	// used. The agent deactivates clients which have not renewed their lease
+	// for a while.
+	RenewalInterval time2.Duration
+}
+
+// AttachOption configures AttachDocument.
+type AttachOption struct {
+	// Mode is the mode of attaching the document. AttachOrCreate is used by
+	// default.
+	Mode AttachMode
+
+	// ReadOnly makes the document read-only in this client. The read-only
+	// document receives remote changes but can not be updated locally.
+	ReadOnly bool
+
+	// SyncMode is the mode of synchronizing the document. SyncRealtime is
+	// used by default.
+	SyncMode SyncMode
 }
 
 // NewClient creates an instance of Client.
-func NewClient(rpcAddr string, opts ...string) (*Client, error) {
-	var k string
-	if len(opts) == 0 {
+func NewClient(rpcAddr string, opts ...Option) (*Client, error) {
+	var opt Option
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	k := opt.Key
+	if k == "" {
 		k = uuid.New().String()
-	} else {
-		k = opts[0]
 	}
 
-	conn, err := grpc.Dial(rpcAddr, grpc.WithInsecure())
+	renewalInterval := opt.RenewalInterval
+	if renewalInterval == 0 {
+		renewalInterval = DefaultRenewalInterval
+	}
+
+	conn, err := grpc.Dial(
+		rpcAddr,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(trace.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		log.Logger.Error(err)
 		return nil, err
@@ -62,6 +194,9 @@ func NewClient(rpcAddr string, opts ...string) (*Client, error) {
 		key:          k,
 		status:       deactivated,
 		attachedDocs: make(map[string]*document.Document),
+		syncModes:    make(map[string]SyncMode),
+
+		renewalInterval: renewalInterval,
 	}, nil
 }
 
@@ -82,7 +217,12 @@ func (c *Client) Close() error {
 // Activate activates this client. That is, it register itself to the agent
 // and receives a unique ID from the agent. The given ID is used to distinguish
 // different clients.
-func (c *Client) Activate(ctx context.Context) error {
+func (c *Client) Activate(ctx context.Context) (err error) {
+	ctx, span := trace.Start(ctx, "client.Activate")
+	defer func() {
+		trace.End(span, err)
+	}()
+
 	if c.status == activated {
 		return nil
 	}
@@ -99,43 +239,99 @@ func (c *Client) Activate(ctx context.Context) error {
 	c.status = activated
 	c.id = time.ActorIDFromHex(reply.ClientId)
 
+	renewalCtx, stopRenewal := context.WithCancel(context.Background())
+	c.stopRenewal = stopRenewal
+	go c.renewLease(renewalCtx, c.id)
+
 	return nil
 }
 
 // Deactivate deactivates this client.
-func (c *Client) Deactivate(ctx context.Context) error {
+func (c *Client) Deactivate(ctx context.Context) (err error) {
 	if c.status == deactivated {
 		return nil
 	}
 
-	_, err := c.client.DeactivateClient(ctx, &api.DeactivateClientRequest{
+	ctx, span := trace.Start(ctx, "client.Deactivate", trace.ClientID.String(c.id.String()))
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	if _, err := c.client.DeactivateClient(ctx, &api.DeactivateClientRequest{
 		ClientId: c.id.String(),
-	})
-	if err != nil {
+	}); err != nil {
 		log.Logger.Error(err)
 		return err
 	}
 
+	c.stopRenewal()
 	c.status = deactivated
 
 	return nil
 }
 
+// renewLease periodically renews the lease of this client on the agent until
+// the given context is done.
+func (c *Client) renewLease(ctx context.Context, id *time.ActorID) {
+	ticker := time2.NewTicker(c.renewalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := c.client.RenewClient(ctx, &api.RenewClientRequest{
+				ClientId: id.String(),
+			}); err != nil && ctx.Err() == nil {
+				log.Logger.Error(err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // AttachDocument attaches the given document to this client. It tells the agent that
-// this client will synchronize the given document.
-func (c *Client) AttachDocument(ctx context.Context, doc *document.Document) error {
+// this client will synchronize the given document. If the document fails to
+// be attached, its local changes are kept in it.
+func (c *Client) AttachDocument(
+	ctx context.Context,
+	doc *document.Document,
+	opts ...AttachOption,
+) (err error) {
 	if c.status != activated {
 		return errClientNotActivated
 	}
 
+	ctx, span := trace.Start(
+		ctx,
+		"client.AttachDocument",
+		trace.ClientID.String(c.id.String()),
+		trace.DocumentKey.String(doc.Key().BSONKey()),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	var opt AttachOption
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
 	doc.SetActor(c.id)
 
+	reqPack := doc.FlushChangePack()
 	res, err := c.client.AttachDocument(ctx, &api.AttachDocumentRequest{
 		ClientId:   c.id.String(),
-		ChangePack: converter.ToChangePack(doc.FlushChangePack()),
+		ChangePack: converter.ToChangePack(reqPack),
+		Mode:       toAttachMode(opt.Mode),
+		ReadOnly:   opt.ReadOnly,
 	})
 	if err != nil {
 		log.Logger.Error(err)
+		doc.RestoreChangePack(reqPack)
+		if isDocumentRemoved(err) {
+			doc.UpdateState(document.Removed)
+		}
 		return err
 	}
 
@@ -148,9 +344,14 @@ func (c *Client) AttachDocument(ctx context.Context, doc *document.Document) err
 		log.Logger.Error(err)
 		return err
 	}
+	if err := setSchema(doc, res.Schema); err != nil {
+		return err
+	}
 
 	doc.UpdateState(document.Attached)
+	doc.SetReadOnly(opt.ReadOnly)
 	c.attachedDocs[doc.Key().BSONKey()] = doc
+	c.syncModes[doc.Key().BSONKey()] = opt.SyncMode
 
 	return nil
 }
@@ -161,21 +362,33 @@ func (c *Client) AttachDocument(ctx context.Context, doc *document.Document) err
 // To collect garbage things like CRDT tombstones left on the document, all the
 // changes should be applied to other replicas before GC time. For this, if the
 // document is no longer used by this client, it should be detached.
-func (c *Client) DetachDocument(ctx context.Context, doc *document.Document) error {
+func (c *Client) DetachDocument(ctx context.Context, doc *document.Document) (err error) {
 	if c.status != activated {
 		return errClientNotActivated
 	}
 
+	ctx, span := trace.Start(
+		ctx,
+		"client.DetachDocument",
+		trace.ClientID.String(c.id.String()),
+		trace.DocumentKey.String(doc.Key().BSONKey()),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
 	if _, ok := c.attachedDocs[doc.Key().BSONKey()]; !ok {
 		return errDocumentNotAttached
 	}
 
+	reqPack := doc.FlushChangePack()
 	res, err := c.client.DetachDocument(ctx, &api.DetachDocumentRequest{
 		ClientId:   c.id.String(),
-		ChangePack: converter.ToChangePack(doc.FlushChangePack()),
+		ChangePack: converter.ToChangePack(reqPack),
 	})
 	if err != nil {
 		log.Logger.Error(err)
+		doc.RestoreChangePack(reqPack)
 		return err
 	}
 
@@ -190,44 +403,572 @@ func (c *Client) DetachDocument(ctx context.Context, doc *document.Document) err
 	}
 
 	doc.UpdateState(document.Detached)
+	doc.SetReadOnly(false)
 	delete(c.attachedDocs, doc.Key().BSONKey())
+	delete(c.syncModes, doc.Key().BSONKey())
 
 	return nil
 }
 
-// PushPull pushes local changes of the attached documents to the Agent and
-// receives changes of the remote replica from the agent then apply them to
-// local documents.
-func (c *Client) PushPull(ctx context.Context) error {
+// AttachDocuments attaches the given documents to this client in a single
+// request. A document that fails to be attached keeps its local changes and
+// does not fail the others. The error of the first one is returned as
+// DocumentError.
+func (c *Client) AttachDocuments(
+	ctx context.Context,
+	docs []*document.Document,
+	opts ...AttachOption,
+) (err error) {
+	if c.status != activated {
+		return errClientNotActivated
+	}
+
+	ctx, span := trace.Start(
+		ctx,
+		"client.AttachDocuments",
+		trace.ClientID.String(c.id.String()),
+		trace.DocumentCount.Int(len(docs)),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	var opt AttachOption
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	reqPacks := make(map[string]*change.Pack)
+	var pbPacks []*api.ChangePack
+	for _, doc := range docs {
+		doc.SetActor(c.id)
+		reqPack := doc.FlushChangePack()
+		reqPacks[doc.Key().BSONKey()] = reqPack
+		pbPacks = append(pbPacks, converter.ToChangePack(reqPack))
+	}
+
+	res, err := c.client.AttachDocuments(ctx, &api.AttachDocumentsRequest{
+		ClientId:    c.id.String(),
+		ChangePacks: pbPacks,
+		Mode:        toAttachMode(opt.Mode),
+		ReadOnly:    opt.ReadOnly,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		restoreChangePacks(docs, reqPacks)
+		return err
+	}
+
+	docErr := c.handleDocumentErrors(docs, reqPacks, res.Errors)
+	for _, pbPack := range res.ChangePacks {
+		pack, err := converter.FromChangePack(pbPack)
+		if err != nil {
+			return err
+		}
+
+		doc := findDocument(docs, pack.DocumentKey)
+		if doc == nil {
+			return errDocumentNotAttached
+		}
+		if err := doc.ApplyChangePack(pack); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		if err := setSchema(doc, res.Schemas[doc.Key().BSONKey()]); err != nil {
+			return err
+		}
+
+		doc.UpdateState(document.Attached)
+		doc.SetReadOnly(opt.ReadOnly)
+		c.attachedDocs[doc.Key().BSONKey()] = doc
+		c.syncModes[doc.Key().BSONKey()] = opt.SyncMode
+	}
+
+	return docErr
+}
+
+// DetachDocuments detaches the given documents from this client in a single
+// request. A document that fails to be detached keeps its local changes and
+// stays attached without failing the others. The error of the first one is
+// returned as DocumentError.
+func (c *Client) DetachDocuments(ctx context.Context, docs ...*document.Document) (err error) {
 	if c.status != activated {
 		return errClientNotActivated
 	}
 
-	for _, doc := range c.attachedDocs {
-		res, err := c.client.PushPull(ctx, &api.PushPullRequest{
-			ClientId:   c.id.String(),
-			ChangePack: converter.ToChangePack(doc.FlushChangePack()),
-		})
+	ctx, span := trace.Start(
+		ctx,
+		"client.DetachDocuments",
+		trace.ClientID.String(c.id.String()),
+		trace.DocumentCount.Int(len(docs)),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	for _, doc := range docs {
+		if _, ok := c.attachedDocs[doc.Key().BSONKey()]; !ok {
+			return errDocumentNotAttached
+		}
+	}
+
+	reqPacks := make(map[string]*change.Pack)
+	var pbPacks []*api.ChangePack
+	for _, doc := range docs {
+		reqPack := doc.FlushChangePack()
+		reqPacks[doc.Key().BSONKey()] = reqPack
+		pbPacks = append(pbPacks, converter.ToChangePack(reqPack))
+	}
+
+	res, err := c.client.DetachDocuments(ctx, &api.DetachDocumentsRequest{
+		ClientId:    c.id.String(),
+		ChangePacks: pbPacks,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		restoreChangePacks(docs, reqPacks)
+		return err
+	}
+
+	docErr := c.handleDocumentErrors(docs, reqPacks, res.Errors)
+	for _, pbPack := range res.ChangePacks {
+		pack, err := converter.FromChangePack(pbPack)
 		if err != nil {
+			return err
+		}
+
+		doc := findDocument(docs, pack.DocumentKey)
+		if doc == nil {
+			return errDocumentNotAttached
+		}
+		if err := doc.ApplyChangePack(pack); err != nil {
 			log.Logger.Error(err)
 			return err
 		}
 
-		pack, err := converter.FromChangePack(res.ChangePack)
+		doc.UpdateState(document.Detached)
+		doc.SetReadOnly(false)
+		delete(c.attachedDocs, doc.Key().BSONKey())
+		delete(c.syncModes, doc.Key().BSONKey())
+	}
+
+	return docErr
+}
+
+// SetSyncMode changes the mode of synchronizing the given attached document.
+func (c *Client) SetSyncMode(doc *document.Document, mode SyncMode) error {
+	if _, ok := c.attachedDocs[doc.Key().BSONKey()]; !ok {
+		return errDocumentNotAttached
+	}
+
+	c.syncModes[doc.Key().BSONKey()] = mode
+	return nil
+}
+
+// PushPull pushes local changes of the given documents to the Agent and
+// receives changes of the remote replica from the agent then apply them to
+// local documents. If no document is given, the attached documents except
+// the ones in SyncManual mode are synchronized. The documents are
+// synchronized in a single request, or two if some are in SyncPushOnly mode.
+// A document that fails to be synchronized keeps its local changes and does
+// not fail the others. The error of the first one is returned as
+// DocumentError.
+func (c *Client) PushPull(ctx context.Context, docs ...*document.Document) (err error) {
+	if c.status != activated {
+		return errClientNotActivated
+	}
+
+	if len(docs) == 0 {
+		for key, doc := range c.attachedDocs {
+			if c.syncModes[key] != SyncManual {
+				docs = append(docs, doc)
+			}
+		}
+	}
+	for _, doc := range docs {
+		if _, ok := c.attachedDocs[doc.Key().BSONKey()]; !ok {
+			return errDocumentNotAttached
+		}
+	}
+
+	ctx, span := trace.Start(
+		ctx,
+		"client.PushPull",
+		trace.ClientID.String(c.id.String()),
+		trace.DocumentCount.Int(len(docs)),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	var pushPullDocs, pushOnlyDocs []*document.Document
+	pushPullPacks := make(map[string]*change.Pack)
+	pushOnlyPacks := make(map[string]*change.Pack)
+	for _, doc := range docs {
+		bsonKey := doc.Key().BSONKey()
+		switch c.syncModes[bsonKey] {
+		case SyncPushOnly:
+			pushOnlyDocs = append(pushOnlyDocs, doc)
+			pushOnlyPacks[bsonKey] = doc.FlushChangePack()
+		case SyncPullOnly:
+			// NOTE: the local changes are kept in the document to push them
+			// later, only the checkpoint is sent to pull remote changes.
+			pushPullDocs = append(pushPullDocs, doc)
+			pushPullPacks[bsonKey] = change.NewPack(doc.Key(), doc.Checkpoint(), nil)
+		default:
+			pushPullDocs = append(pushPullDocs, doc)
+			pushPullPacks[bsonKey] = doc.FlushChangePack()
+		}
+	}
+
+	var docErr error
+	if len(pushPullDocs) > 0 {
+		if err := c.pushPull(ctx, pushPullDocs, pushPullPacks, false); err != nil {
+			if _, ok := err.(*DocumentError); !ok {
+				restoreChangePacks(pushOnlyDocs, pushOnlyPacks)
+				return err
+			}
+			docErr = err
+		}
+	}
+	if len(pushOnlyDocs) > 0 {
+		if err := c.pushPull(ctx, pushOnlyDocs, pushOnlyPacks, true); err != nil {
+			if _, ok := err.(*DocumentError); !ok {
+				return err
+			}
+			if docErr == nil {
+				docErr = err
+			}
+		}
+	}
+
+	return docErr
+}
+
+// pushPull sends the given packs of the given documents to the agent and
+// applies the pulled packs to the documents. If only some of the documents
+// failed, it returns the DocumentError of the first one after synchronizing
+// the others.
+func (c *Client) pushPull(
+	ctx context.Context,
+	docs []*document.Document,
+	reqPacks map[string]*change.Pack,
+	pushOnly bool,
+) error {
+	var pbPacks []*api.ChangePack
+	for _, doc := range docs {
+		pbPacks = append(pbPacks, converter.ToChangePack(reqPacks[doc.Key().BSONKey()]))
+	}
+
+	res, err := c.client.PushPullDocuments(ctx, &api.PushPullDocumentsRequest{
+		ClientId:    c.id.String(),
+		ChangePacks: pbPacks,
+		PushOnly:    pushOnly,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		restoreChangePacks(docs, reqPacks)
+		return err
+	}
+
+	// NOTE: the documents removed by other clients are no longer
+	// synchronized instead of failing the others. Their local changes which
+	// were not pushed are kept in the documents.
+	for _, pbKey := range res.RemovedDocumentKeys {
+		doc := findDocument(docs, converter.FromDocumentKey(pbKey))
+		if doc == nil {
+			continue
+		}
+		doc.RestoreChangePack(reqPacks[doc.Key().BSONKey()])
+		doc.UpdateState(document.Removed)
+		delete(c.attachedDocs, doc.Key().BSONKey())
+		delete(c.syncModes, doc.Key().BSONKey())
+	}
+
+	docErr := c.handleDocumentErrors(docs, reqPacks, res.Errors)
+	for _, pbPack := range res.ChangePacks {
+		pack, err := converter.FromChangePack(pbPack)
 		if err != nil {
 			return err
 		}
 
+		doc := findDocument(docs, pack.DocumentKey)
+		if doc == nil {
+			return errDocumentNotAttached
+		}
 		if err := doc.ApplyChangePack(pack); err != nil {
 			log.Logger.Error(err)
 			return err
 		}
 	}
 
+	return docErr
+}
+
+// handleDocumentErrors puts the flushed changes back to the documents that
+// failed in a batch request and returns the DocumentError of the first one.
+func (c *Client) handleDocumentErrors(
+	docs []*document.Document,
+	reqPacks map[string]*change.Pack,
+	pbErrors []*api.DocumentError,
+) error {
+	var firstErr error
+	for _, pbErr := range pbErrors {
+		docErr := fromDocumentError(pbErr)
+		log.Logger.Error(docErr)
+
+		doc := findDocument(docs, docErr.Key)
+		if doc == nil {
+			continue
+		}
+		doc.RestoreChangePack(reqPacks[doc.Key().BSONKey()])
+		if isDocumentRemoved(docErr) {
+			doc.UpdateState(document.Removed)
+			delete(c.attachedDocs, doc.Key().BSONKey())
+			delete(c.syncModes, doc.Key().BSONKey())
+		}
+
+		if firstErr == nil {
+			firstErr = docErr
+		}
+	}
+
+	return firstErr
+}
+
+// RemoveDocument removes the given document from the agent. The removed
+// document can no longer be attached or synchronized by any client, and it is
+// purged by the agent later.
+func (c *Client) RemoveDocument(ctx context.Context, doc *document.Document) (err error) {
+	if c.status != activated {
+		return errClientNotActivated
+	}
+
+	ctx, span := trace.Start(
+		ctx,
+		"client.RemoveDocument",
+		trace.ClientID.String(c.id.String()),
+		trace.DocumentKey.String(doc.Key().BSONKey()),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	if _, err := c.client.RemoveDocument(ctx, &api.RemoveDocumentRequest{
+		ClientId:    c.id.String(),
+		DocumentKey: converter.ToDocumentKey(doc.Key()),
+	}); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	doc.UpdateState(document.Removed)
+	delete(c.attachedDocs, doc.Key().BSONKey())
+	delete(c.syncModes, doc.Key().BSONKey())
+
 	return nil
 }
 
+// ListDocuments returns the documents of the given collection whose name
+// starts with the given prefix in the order of the name. To page through the
+// collection, pass the name of the last document of the previous page as
+// after. limit bounds the number of documents; 0 means no limit.
+func (c *Client) ListDocuments(
+	ctx context.Context,
+	collection string,
+	prefix string,
+	after string,
+	limit uint32,
+) (docInfos []*DocumentInfo, err error) {
+	ctx, span := trace.Start(ctx, "client.ListDocuments")
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	res, err := c.client.ListDocuments(ctx, &api.ListDocumentsRequest{
+		Collection: collection,
+		Prefix:     prefix,
+		After:      after,
+		Limit:      limit,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	for _, pbDocInfo := range res.DocumentInfos {
+		docInfo, err := fromPBDocumentInfo(pbDocInfo)
+		if err != nil {
+			return nil, err
+		}
+		docInfos = append(docInfos, docInfo)
+	}
+
+	return docInfos, nil
+}
+
+// ListChanges returns the changes of the document of the given key whose
+// serverSeq is between from and to. If to is 0, changes up to the last one
+// are returned. limit bounds the number of changes to page through long
+// histories; 0 means no limit.
+func (c *Client) ListChanges(
+	ctx context.Context,
+	k *key.Key,
+	from uint64,
+	to uint64,
+	limit uint32,
+) (changes []*change.Change, err error) {
+	ctx, span := trace.Start(
+		ctx,
+		"client.ListChanges",
+		trace.DocumentKey.String(k.BSONKey()),
+		trace.ServerSeqFrom.Int64(int64(from)),
+		trace.ServerSeqTo.Int64(int64(to)),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	res, err := c.client.ListChanges(ctx, &api.ListChangesRequest{
+		DocumentKey:   converter.ToDocumentKey(k),
+		FromServerSeq: from,
+		ToServerSeq:   to,
+		Limit:         limit,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return converter.FromChanges(res.Changes), nil
+}
+
+// MaterializeDocument returns the JSON of the document of the given key as it
+// was at the given serverSeq. If serverSeq is 0, the latest state is returned.
+func (c *Client) MaterializeDocument(
+	ctx context.Context,
+	k *key.Key,
+	serverSeq uint64,
+) (string, error) {
+	return c.materializeDocument(ctx, &api.MaterializeDocumentRequest{
+		DocumentKey: converter.ToDocumentKey(k),
+		ServerSeq:   serverSeq,
+	})
+}
+
+// MaterializeDocumentAt returns the JSON of the document of the given key as
+// it was at the given time.
+func (c *Client) MaterializeDocumentAt(
+	ctx context.Context,
+	k *key.Key,
+	at time2.Time,
+) (string, error) {
+	timestamp, err := types.TimestampProto(at)
+	if err != nil {
+		return "", err
+	}
+
+	return c.materializeDocument(ctx, &api.MaterializeDocumentRequest{
+		DocumentKey: converter.ToDocumentKey(k),
+		Timestamp:   timestamp,
+	})
+}
+
+func (c *Client) materializeDocument(
+	ctx context.Context,
+	req *api.MaterializeDocumentRequest,
+) (snapshot string, err error) {
+	ctx, span := trace.Start(
+		ctx,
+		"client.MaterializeDocument",
+		trace.DocumentKey.String(converter.FromDocumentKey(req.DocumentKey).BSONKey()),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
+	res, err := c.client.MaterializeDocument(ctx, req)
+	if err != nil {
+		log.Logger.Error(err)
+		return "", err
+	}
+
+	return res.Snapshot, nil
+}
+
+// ID returns the ID of this client given by the agent. It is nil until the
+// client is activated.
+func (c *Client) ID() *time.ActorID {
+	return c.id
+}
+
 // IsActivate returns whether this client is active or not.
 func (c *Client) IsActive() bool {
 	return c.status == activated
 }
+
+// setSchema sets the schema of the given JSON given by the agent to the given
+// document, so that the changes violating it are rejected by Update before
+// they are pushed. The empty JSON means that the agent has no schema for the
+// document, and the schema set by the caller, if any, is kept.
+func setSchema(doc *document.Document, data string) error {
+	if data == "" {
+		return nil
+	}
+
+	s, err := schema.Parse([]byte(data))
+	if err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	doc.SetSchema(s)
+	return nil
+}
+
+// restoreChangePacks puts the given flushed packs back to the documents when
+// the request of them failed.
+func restoreChangePacks(docs []*document.Document, reqPacks map[string]*change.Pack) {
+	for _, doc := range docs {
+		if reqPack, ok := reqPacks[doc.Key().BSONKey()]; ok {
+			doc.RestoreChangePack(reqPack)
+		}
+	}
+}
+
+// findDocument returns the document of the given key among the given
+// documents. It returns nil if there is no such document.
+func findDocument(docs []*document.Document, k *key.Key) *document.Document {
+	for _, doc := range docs {
+		if doc.Key().BSONKey() == k.BSONKey() {
+			return doc
+		}
+	}
+
+	return nil
+}
+
+func toAttachMode(mode AttachMode) api.AttachMode {
+	switch mode {
+	case CreateOrFail:
+		return api.AttachMode_CREATE_OR_FAIL
+	case AttachExisting:
+		return api.AttachMode_ATTACH_EXISTING
+	default:
+		return api.AttachMode_ATTACH_OR_CREATE
+	}
+}
+
+// isDocumentRemoved returns whether the given error is returned by the agent
+// because the document is removed.
+func isDocumentRemoved(err error) bool {
+	for _, detail := range grpcstatus.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok &&
+			info.Domain == converter.ErrorDomain &&
+			info.Reason == converter.ReasonDocumentRemoved {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,17 +2,27 @@ package client_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/hackerwins/yorkie/api"
+	"github.com/hackerwins/yorkie/api/converter"
 	"github.com/hackerwins/yorkie/client"
 	"github.com/hackerwins/yorkie/pkg/document"
 	"github.com/hackerwins/yorkie/pkg/document/proxy"
 	"github.com/hackerwins/yorkie/testhelper"
 	"github.com/hackerwins/yorkie/yorkie"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
+	"github.com/hackerwins/yorkie/yorkie/documents"
 )
 
 const (
@@ -73,6 +83,272 @@ func TestClient(t *testing.T) {
 	})
 }
 
+func TestClientLease(t *testing.T) {
+	conf := testhelper.TestConfig()
+	conf.Housekeeping.ClientTTLSec = 2
+
+	testhelper.WithYorkieConfig(t, conf, func(t *testing.T, r *yorkie.Yorkie) {
+		ctx := context.Background()
+		cli, err := client.NewClient(testRPCAddr, client.Option{
+			RenewalInterval: 100 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := cli.Close(); err != nil {
+				t.Error(err)
+			}
+		}()
+		if err := cli.Activate(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		// A client that crashed without renewing its lease.
+		conn, err := grpc.Dial(testRPCAddr, grpc.WithInsecure())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				t.Error(err)
+			}
+		}()
+		rpcCli := api.NewYorkieClient(conn)
+		res, err := rpcCli.ActivateClient(ctx, &api.ActivateClientRequest{
+			ClientKey: t.Name(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		time.Sleep(4 * time.Second)
+
+		_, err = rpcCli.RenewClient(ctx, &api.RenewClientRequest{
+			ClientId: res.ClientId,
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = rpcCli.RenewClient(ctx, &api.RenewClientRequest{
+			ClientId: cli.ID().String(),
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestChangeCompaction(t *testing.T) {
+	conf := testhelper.TestConfig()
+	conf.Housekeeping.ChangeCompactionIntervalSec = 1
+
+	withYorkieConfigAndTwoClients(t, conf, func(t *testing.T, r *yorkie.Yorkie, c1 *client.Client, c2 *client.Client) {
+		ctx := context.Background()
+		doc1 := document.New(testCollection, t.Name())
+		if err := c1.AttachDocument(ctx, doc1); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 5; i++ {
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("k%d", i), i)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+		}
+		if err := c1.PushPull(ctx); err != nil {
+			t.Error(err)
+		}
+
+		// wait for the housekeeping to create a snapshot and compact changes.
+		time.Sleep(3 * time.Second)
+
+		_, err := c1.ListChanges(ctx, doc1.Key(), 1, 0, 0)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		changes, err := c1.ListChanges(ctx, doc1.Key(), 0, 0, 0)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 0)
+
+		// a client attaching the document receives a snapshot.
+		doc2 := document.New(testCollection, t.Name())
+		if err := c2.AttachDocument(ctx, doc2); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.Remove("k1")
+			root.SetNewArray("k5").AddInteger(5)
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		syncThenAssertEqual(t, c1, c2, doc1, doc2)
+
+		snapshot, err := c1.MaterializeDocument(ctx, doc1.Key(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, doc1.Marshal(), snapshot)
+	})
+}
+
+func TestDocumentRemoval(t *testing.T) {
+	withYorkieAndTwoClients(t, func(t *testing.T, r *yorkie.Yorkie, c1 *client.Client, c2 *client.Client) {
+		ctx := context.Background()
+
+		doc := document.New(testCollection, t.Name())
+		err := c1.AttachDocument(ctx, doc, client.AttachOption{Mode: client.AttachExisting})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		doc1 := document.New(testCollection, t.Name())
+		if err := c1.AttachDocument(ctx, doc1, client.AttachOption{Mode: client.CreateOrFail}); err != nil {
+			t.Fatal(err)
+		}
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+
+		doc2 := document.New(testCollection, t.Name())
+		err = c2.AttachDocument(ctx, doc2, client.AttachOption{Mode: client.CreateOrFail})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		if err := c2.AttachDocument(ctx, doc2, client.AttachOption{Mode: client.AttachExisting}); err != nil {
+			t.Fatal(err)
+		}
+		syncThenAssertEqual(t, c1, c2, doc1, doc2)
+
+		if err := c1.RemoveDocument(ctx, doc1); err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, doc1.IsRemoved())
+
+		// the other client stops synchronizing the removed document, keeping
+		// the local changes which were not pushed.
+		if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		if err := c2.PushPull(ctx); err != nil {
+			t.Fatal(err)
+		}
+		assert.True(t, doc2.IsRemoved())
+		assert.True(t, doc2.HasLocalChanges())
+
+		doc3 := document.New(testCollection, t.Name())
+		if err := doc3.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v3")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		err = c1.AttachDocument(ctx, doc3)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.True(t, doc3.IsRemoved())
+		assert.True(t, doc3.HasLocalChanges())
+
+		// wait for the housekeeping to purge the removed document.
+		time.Sleep(3 * time.Second)
+
+		_, err = c1.ListChanges(ctx, doc1.Key(), 0, 0, 0)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		doc4 := document.New(testCollection, t.Name())
+		if err := c1.AttachDocument(ctx, doc4, client.AttachOption{Mode: client.CreateOrFail}); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "{}", doc4.Marshal())
+	})
+}
+
+func TestDocumentExport(t *testing.T) {
+	conf := testhelper.TestConfig()
+	withYorkieConfigAndTwoClients(t, conf, func(t *testing.T, r *yorkie.Yorkie, c1 *client.Client, c2 *client.Client) {
+		ctx := context.Background()
+		be, err := backend.New(conf.Mongo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			assert.NoError(t, be.Close())
+		}()
+
+		doc1 := document.New(testCollection, t.Name())
+		if err := c1.AttachDocument(ctx, doc1); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("k%d", i), i)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+		}
+		if err := c1.PushPull(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, snapshotOnly := range []bool{false, true} {
+			export, err := documents.Export(ctx, be, doc1.Key(), snapshotOnly)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := converter.DocumentExportToJSON(export)
+			if err != nil {
+				t.Fatal(err)
+			}
+			export, err = converter.JSONToDocumentExport(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			docName := fmt.Sprintf("%s-%t", t.Name(), snapshotOnly)
+			export.DocumentKey.Document = docName
+			if _, err := documents.Import(ctx, be, export); err != nil {
+				t.Fatal(err)
+			}
+			_, err = documents.Import(ctx, be, export)
+			assert.Equal(t, mongo.ErrDocumentAlreadyExists, err)
+
+			// the imported document can be synchronized like the original.
+			doc2 := document.New(testCollection, docName)
+			if err := c2.AttachDocument(ctx, doc2); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+			if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewArray("k3").AddInteger(3)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := c2.PushPull(ctx, doc2); err != nil {
+				t.Fatal(err)
+			}
+			snapshot, err := c2.MaterializeDocument(ctx, doc2.Key(), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, doc2.Marshal(), snapshot)
+		}
+
+		export, err := documents.Export(ctx, be, doc1.Key(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		export.DocumentKey.Document = t.Name() + "-invalid"
+		export.Changes = export.Changes[1:]
+		_, err = documents.Import(ctx, be, export)
+		assert.Equal(t, documents.ErrInvalidExport, err)
+	})
+}
+
 func TestClientAndDocument(t *testing.T) {
 	withYorkieAndTwoClients(t, func(t *testing.T, r *yorkie.Yorkie, c1 *client.Client, c2 *client.Client) {
 		t.Run("attach/detach test", func(t *testing.T) {
@@ -96,6 +372,251 @@ func TestClientAndDocument(t *testing.T) {
 			assert.False(t, doc.IsAttached())
 		})
 
+		t.Run("attach/detach documents test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name()+"1")
+			doc2 := document.New(testCollection, t.Name()+"2")
+			if err := c1.AttachDocuments(ctx, []*document.Document{doc1, doc2}); err != nil {
+				t.Fatal(err)
+			}
+			assert.True(t, doc1.IsAttached())
+			assert.True(t, doc2.IsAttached())
+
+			doc3 := document.New(testCollection, t.Name()+"1")
+			doc4 := document.New(testCollection, t.Name()+"2")
+			if err := c2.AttachDocuments(ctx, []*document.Document{doc3, doc4}); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := doc4.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k2", "v2")
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			syncThenAssertEqual(t, c1, c2, doc1, doc3)
+			assert.Equal(t, doc2.Marshal(), doc4.Marshal())
+
+			if err := c1.DetachDocuments(ctx, doc1, doc2); err != nil {
+				t.Fatal(err)
+			}
+			assert.False(t, doc1.IsAttached())
+			assert.False(t, doc2.IsAttached())
+
+			// the detached documents can be attached again.
+			doc5 := document.New(testCollection, t.Name()+"1")
+			if err := c1.AttachDocument(ctx, doc5); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, doc3.Marshal(), doc5.Marshal())
+		})
+
+		t.Run("attach documents partially test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name()+"1")
+			if err := c2.AttachDocument(ctx, doc1); err != nil {
+				t.Fatal(err)
+			}
+
+			// the document that fails keeps its local changes and does not
+			// fail the others.
+			doc2 := document.New(testCollection, t.Name()+"1")
+			doc3 := document.New(testCollection, t.Name()+"2")
+			for _, doc := range []*document.Document{doc2, doc3} {
+				if err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.SetString("k1", "v1")
+					return nil
+				}); err != nil {
+					t.Error(err)
+				}
+			}
+			err := c1.AttachDocuments(
+				ctx,
+				[]*document.Document{doc2, doc3},
+				client.AttachOption{Mode: client.CreateOrFail},
+			)
+			docErr, ok := err.(*client.DocumentError)
+			assert.True(t, ok)
+			assert.Equal(t, doc2.Key().BSONKey(), docErr.Key.BSONKey())
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+			assert.False(t, doc2.IsAttached())
+			assert.True(t, doc2.HasLocalChanges())
+			assert.True(t, doc3.IsAttached())
+			assert.False(t, doc3.HasLocalChanges())
+
+			// the failed document can be attached again with its changes.
+			if err := c1.AttachDocuments(ctx, []*document.Document{doc2}); err != nil {
+				t.Fatal(err)
+			}
+			syncThenAssertEqual(t, c1, c2, doc2, doc1)
+			assert.Equal(t, `{"k1":"v1"}`, doc1.Marshal())
+		})
+
+		t.Run("sync mode test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name()+"1")
+			doc2 := document.New(testCollection, t.Name()+"2")
+			if err := c1.AttachDocument(ctx, doc1); err != nil {
+				t.Fatal(err)
+			}
+			if err := c1.AttachDocument(ctx, doc2, client.AttachOption{SyncMode: client.SyncManual}); err != nil {
+				t.Fatal(err)
+			}
+			doc3 := document.New(testCollection, t.Name()+"1")
+			doc4 := document.New(testCollection, t.Name()+"2")
+			if err := c2.AttachDocuments(ctx, []*document.Document{doc3, doc4}); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, doc := range []*document.Document{doc1, doc2} {
+				if err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.SetNewArray("k1").AddInteger(1)
+					return nil
+				}); err != nil {
+					t.Error(err)
+				}
+			}
+
+			// the document in manual mode is synchronized only if it is given.
+			syncThenAssertEqual(t, c1, c2, doc1, doc3)
+			assert.Equal(t, "{}", doc4.Marshal())
+			if err := c1.PushPull(ctx, doc2); err != nil {
+				t.Error(err)
+			}
+			if err := c2.PushPull(ctx, doc4); err != nil {
+				t.Error(err)
+			}
+			assert.Equal(t, doc2.Marshal(), doc4.Marshal())
+
+			// the document in push-only mode does not pull remote changes.
+			if err := c1.SetSyncMode(doc1, client.SyncPushOnly); err != nil {
+				t.Fatal(err)
+			}
+			if err := doc3.Update(func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").AddInteger(2)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := c2.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").AddInteger(3)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			if err := c2.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			// the order of concurrent elements is up to the replicas, so only the
+			// number of them is checked before the replicas converge.
+			assert.Equal(t, `{"k1":[1,3]}`, doc1.Marshal())
+			assert.Equal(t, 3, doc3.Root().Get("k1").Len())
+
+			if err := c1.SetSyncMode(doc1, client.SyncRealtime); err != nil {
+				t.Fatal(err)
+			}
+			syncThenAssertEqual(t, c1, c2, doc1, doc3)
+
+			// the document in pull-only mode keeps local changes.
+			if err := c1.SetSyncMode(doc1, client.SyncPullOnly); err != nil {
+				t.Fatal(err)
+			}
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").AddInteger(4)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := doc3.Update(func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").AddInteger(5)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := c2.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			if err := c2.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			assert.True(t, doc1.HasLocalChanges())
+			assert.Equal(t, 5, doc1.Root().Get("k1").Len())
+			assert.Equal(t, 4, doc3.Root().Get("k1").Len())
+
+			if err := c1.SetSyncMode(doc1, client.SyncRealtime); err != nil {
+				t.Fatal(err)
+			}
+			syncThenAssertEqual(t, c1, c2, doc1, doc3)
+		})
+
+		t.Run("read-only attach test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name())
+			if err := c1.AttachDocument(ctx, doc1); err != nil {
+				t.Fatal(err)
+			}
+
+			doc2 := document.New(testCollection, t.Name())
+			if err := c2.AttachDocument(ctx, doc2, client.AttachOption{ReadOnly: true}); err != nil {
+				t.Fatal(err)
+			}
+			assert.True(t, doc2.IsReadOnly())
+
+			// the read-only document still receives remote changes.
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+
+			err := doc2.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v2")
+				return nil
+			})
+			assert.Equal(t, document.ErrDocumentReadOnly, err)
+
+			// the agent rejects changes pushed by a read-only client.
+			doc3 := document.New(testCollection, t.Name())
+			if err := doc3.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k2", "v2")
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			cli, err := client.NewClient(testRPCAddr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				assert.NoError(t, cli.Close())
+			}()
+			if err := cli.Activate(ctx); err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				assert.NoError(t, cli.Deactivate(ctx))
+			}()
+			err = cli.AttachDocument(ctx, doc3, client.AttachOption{ReadOnly: true})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+
 		t.Run("causal nested array test", func(t *testing.T) {
 			ctx := context.Background()
 			doc1 := document.New(testCollection, t.Name())
@@ -376,6 +897,46 @@ func TestClientAndDocument(t *testing.T) {
 			syncThenAssertEqual(t, c1, c2, doc1, doc2)
 		})
 
+		t.Run("concurrent array move test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name())
+			if err := c1.AttachDocument(ctx, doc1); err != nil {
+				t.Error(err)
+			}
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewArray("k1").AddString("v1").AddString("v2").AddString("v3")
+				return nil
+			}, "new array and add v1, v2, v3"); err != nil {
+				t.Error(err)
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+
+			doc2 := document.New(testCollection, t.Name())
+			if err := c2.AttachDocument(ctx, doc2); err != nil {
+				t.Error(err)
+			}
+
+			if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				return arr.MoveLast(arr.Get(0))
+			}, "move v1 to the last by c1"); err != nil {
+				t.Error(err)
+			}
+			if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				if err := arr.MoveAfter(arr.Get(1), arr.Get(0)); err != nil {
+					return err
+				}
+				return arr.InsertAt(1, "v4")
+			}, "move v1 after v2 and insert v4 by c2"); err != nil {
+				t.Error(err)
+			}
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+			assert.Equal(t, 4, strings.Count(doc1.Marshal(), `"v`))
+		})
+
 		t.Run("concurrent complex test", func(t *testing.T) {
 			ctx := context.Background()
 
@@ -481,6 +1042,274 @@ func TestClientAndDocument(t *testing.T) {
 			}
 			syncThenAssertEqual(t, c1, c2, doc1, doc2)
 		})
+
+		t.Run("list documents test", func(t *testing.T) {
+			ctx := context.Background()
+			collection := "list-documents"
+			var docs []*document.Document
+			for _, name := range []string{"a1", "a2", "a3", "b1"} {
+				docs = append(docs, document.New(collection, name))
+			}
+			if err := c1.AttachDocuments(ctx, docs); err != nil {
+				t.Fatal(err)
+			}
+			if err := docs[1].Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := c1.PushPull(ctx, docs[1]); err != nil {
+				t.Error(err)
+			}
+
+			docInfos, err := c1.ListDocuments(ctx, collection, "a", "", 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, docInfos, 2)
+			assert.Equal(t, "list-documents$a1", docInfos[0].Key)
+			assert.Equal(t, "list-documents$a2", docInfos[1].Key)
+			assert.Equal(t, uint64(1), docInfos[1].ServerSeq)
+
+			docInfos, err = c1.ListDocuments(ctx, collection, "a", "a2", 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, docInfos, 1)
+			assert.Equal(t, "list-documents$a3", docInfos[0].Key)
+
+			docInfos, err = c1.ListDocuments(ctx, collection, "", "", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, docInfos, 4)
+
+			_, err = c1.ListDocuments(ctx, "", "", "", 0)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run("list changes test", func(t *testing.T) {
+			ctx := context.Background()
+			doc := document.New(testCollection, t.Name())
+			if err := c1.AttachDocument(ctx, doc); err != nil {
+				t.Error(err)
+			}
+
+			for i := 0; i < 3; i++ {
+				if err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.SetInteger("k1", i)
+					return nil
+				}, "update k1 with %d", i); err != nil {
+					t.Error(err)
+				}
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+
+			changes, err := c1.ListChanges(ctx, doc.Key(), 0, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, changes, 3)
+			assert.Equal(t, "update k1 with 0", changes[0].Message())
+			assert.Equal(t, uint64(1), changes[0].ServerSeq())
+
+			changes, err = c1.ListChanges(ctx, doc.Key(), 2, 0, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, changes, 1)
+			assert.Equal(t, "update k1 with 1", changes[0].Message())
+			assert.Equal(t, uint64(2), changes[0].ServerSeq())
+		})
+
+		t.Run("materialize document test", func(t *testing.T) {
+			ctx := context.Background()
+			doc := document.New(testCollection, t.Name())
+			if err := c1.AttachDocument(ctx, doc); err != nil {
+				t.Error(err)
+			}
+
+			for i := 0; i < 3; i++ {
+				if err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.SetNewArray("k1").AddInteger(i)
+					return nil
+				}, "update k1 with %d", i); err != nil {
+					t.Error(err)
+				}
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+
+			snapshot, err := c1.MaterializeDocument(ctx, doc.Key(), 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, `{"k1":[1]}`, snapshot)
+
+			snapshot, err = c1.MaterializeDocument(ctx, doc.Key(), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, doc.Marshal(), snapshot)
+
+			snapshot, err = c1.MaterializeDocumentAt(ctx, doc.Key(), time.Now().Add(-time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "{}", snapshot)
+
+			snapshot, err = c1.MaterializeDocumentAt(ctx, doc.Key(), time.Now().Add(time.Hour))
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, doc.Marshal(), snapshot)
+		})
+
+		t.Run("materialize document from snapshot test", func(t *testing.T) {
+			ctx := context.Background()
+			doc := document.New(testCollection, t.Name())
+			if err := c1.AttachDocument(ctx, doc); err != nil {
+				t.Error(err)
+			}
+
+			if err := doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewText("k1").Edit(0, 0, "ABCD")
+				root.SetNewArray("k2").AddInteger(1).AddInteger(2).AddInteger(3)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			for i := 0; i < 3; i++ {
+				if err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.GetText("k1").Edit(i, i+1, fmt.Sprintf("%d", i))
+					root.GetArray("k2").Remove(0)
+					return nil
+				}); err != nil {
+					t.Error(err)
+				}
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+			expected := doc.Marshal()
+
+			// wait for the housekeeping to create a snapshot.
+			time.Sleep(2 * time.Second)
+
+			if err := doc.Update(func(root *proxy.ObjectProxy) error {
+				root.GetText("k1").Edit(0, 2, "")
+				root.GetArray("k2").AddInteger(4)
+				return nil
+			}); err != nil {
+				t.Error(err)
+			}
+			if err := c1.PushPull(ctx); err != nil {
+				t.Error(err)
+			}
+
+			snapshot, err := c1.MaterializeDocument(ctx, doc.Key(), 4)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, expected, snapshot)
+
+			snapshot, err = c1.MaterializeDocument(ctx, doc.Key(), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, doc.Marshal(), snapshot)
+		})
+	})
+}
+
+func TestSchemaValidation(t *testing.T) {
+	conf := testhelper.TestConfig()
+	conf.Schemas = map[string]json.RawMessage{
+		testCollection: json.RawMessage(`{
+			"type": "object",
+			"properties": {
+				"count": {"type": "integer"},
+				"tags": {"type": "array", "items": {"type": "string"}}
+			}
+		}`),
+	}
+
+	withYorkieConfigAndTwoClients(t, conf, func(t *testing.T, r *yorkie.Yorkie, c1 *client.Client, c2 *client.Client) {
+		ctx := context.Background()
+		doc1 := document.New(testCollection, t.Name())
+		if err := c1.AttachDocument(ctx, doc1); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("count", 1)
+			root.SetNewArray("tags").AddString("a")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		assert.NoError(t, c1.PushPull(ctx))
+
+		// the attached document is validated with the schema of the agent.
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("count", "1")
+			return nil
+		})
+		assert.Equal(t, "schema violation at /count: expected integer but got string", err.Error())
+
+		// the agent rejects the changes of a client that does not validate
+		// them, and the client keeps them.
+		doc2 := document.New(testCollection, t.Name())
+		if err := c2.AttachDocument(ctx, doc2); err != nil {
+			t.Fatal(err)
+		}
+		assert.NotNil(t, doc2.Schema())
+		doc2.SetSchema(nil)
+		if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("tags").AddInteger(2)
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		err = c2.PushPull(ctx)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "schema violation at /tags/1")
+		assert.True(t, doc2.HasLocalChanges())
+
+		// the other documents of the request are still synchronized.
+		doc3 := document.New(testCollection, t.Name()+"2")
+		if err := c2.AttachDocument(ctx, doc3); err != nil {
+			t.Fatal(err)
+		}
+		if err := doc3.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("count", 2)
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		err = c2.PushPull(ctx)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.False(t, doc3.HasLocalChanges())
+
+		// the valid changes after the cached validation are accepted.
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("tags").AddString("b")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		assert.NoError(t, c1.PushPull(ctx))
+
+		snapshot, err := c1.MaterializeDocument(ctx, doc1.Key(), 0)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"count":1,"tags":["a","b"]}`, snapshot)
+		snapshot, err = c1.MaterializeDocument(ctx, doc3.Key(), 0)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"count":2}`, snapshot)
 	})
 }
 
@@ -520,7 +1349,15 @@ func withYorkieAndTwoClients(
 	t *testing.T,
 	f func(t *testing.T, y *yorkie.Yorkie, c1 *client.Client, c2 *client.Client),
 ) {
-	testhelper.WithYorkie(t, func(t *testing.T, r *yorkie.Yorkie) {
+	withYorkieConfigAndTwoClients(t, testhelper.TestConfig(), f)
+}
+
+func withYorkieConfigAndTwoClients(
+	t *testing.T,
+	conf *yorkie.Config,
+	f func(t *testing.T, y *yorkie.Yorkie, c1 *client.Client, c2 *client.Client),
+) {
+	testhelper.WithYorkieConfig(t, conf, func(t *testing.T, r *yorkie.Yorkie) {
 		c1, err := client.NewClient(testRPCAddr)
 		if err != nil {
 			t.Fatal(err)
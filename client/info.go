@@ -0,0 +1,104 @@
+package client
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/hackerwins/yorkie/api"
+)
+
+// DocumentInfo is the information of a document stored in the agent.
+type DocumentInfo struct {
+	ID         string
+	Key        string
+	ServerSeq  uint64
+	Owner      string
+	CreatedAt  time.Time
+	AccessedAt time.Time
+	UpdatedAt  time.Time
+
+	// RemovedAt is the time when the document was removed. It is zero if the
+	// document is not removed.
+	RemovedAt time.Time
+}
+
+// ClientInfo is the information of a client registered in the agent.
+type ClientInfo struct {
+	ID        string
+	Key       string
+	Status    string
+	Documents map[string]*ClientDocumentInfo
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ClientDocumentInfo is the information of a document attached to a client.
+type ClientDocumentInfo struct {
+	Status    string
+	ServerSeq uint64
+	ClientSeq uint32
+}
+
+// fromPBDocumentInfo converts the given Protobuf format to DocumentInfo.
+func fromPBDocumentInfo(pbInfo *api.DocumentInfo) (*DocumentInfo, error) {
+	createdAt, err := types.TimestampFromProto(pbInfo.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	accessedAt, err := types.TimestampFromProto(pbInfo.AccessedAt)
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := types.TimestampFromProto(pbInfo.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	var removedAt time.Time
+	if pbInfo.RemovedAt != nil {
+		if removedAt, err = types.TimestampFromProto(pbInfo.RemovedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DocumentInfo{
+		ID:         pbInfo.Id,
+		Key:        pbInfo.Key,
+		ServerSeq:  pbInfo.ServerSeq,
+		Owner:      pbInfo.Owner,
+		CreatedAt:  createdAt,
+		AccessedAt: accessedAt,
+		UpdatedAt:  updatedAt,
+		RemovedAt:  removedAt,
+	}, nil
+}
+
+// fromPBClientInfo converts the given Protobuf format to ClientInfo.
+func fromPBClientInfo(pbInfo *api.ClientInfo) (*ClientInfo, error) {
+	createdAt, err := types.TimestampFromProto(pbInfo.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := types.TimestampFromProto(pbInfo.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make(map[string]*ClientDocumentInfo)
+	for docID, pbDocInfo := range pbInfo.Documents {
+		documents[docID] = &ClientDocumentInfo{
+			Status:    pbDocInfo.Status,
+			ServerSeq: pbDocInfo.ServerSeq,
+			ClientSeq: pbDocInfo.ClientSeq,
+		}
+	}
+
+	return &ClientInfo{
+		ID:        pbInfo.Id,
+		Key:       pbInfo.Key,
+		Status:    pbInfo.Status,
+		Documents: documents,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
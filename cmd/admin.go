@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hackerwins/yorkie/client"
+	"github.com/hackerwins/yorkie/pkg/log"
+)
+
+var (
+	flagRPCAddr    string
+	flagAdminToken string
+)
+
+func newAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin [command]",
+		Short: "Manages clients and documents through the Admin service of the agent.",
+	}
+	cmd.PersistentFlags().StringVar(
+		&flagRPCAddr,
+		"rpc-addr",
+		fmt.Sprintf("localhost:%d", defaultConfig.RPCPort),
+		"address of the agent",
+	)
+	cmd.PersistentFlags().StringVar(
+		&flagAdminToken,
+		"admin-token",
+		"",
+		"admin token of the agent",
+	)
+	cmd.AddCommand(newAdminDeactivateClientCmd())
+	cmd.AddCommand(newAdminDetachDocumentCmd())
+	cmd.AddCommand(newAdminRemoveDocumentCmd())
+
+	return cmd
+}
+
+func newAdminDeactivateClientCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deactivate-client <client-id>",
+		Short: "Deactivates the client after detaching all its documents.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withAdminClient(func(ctx context.Context, admin *client.AdminClient) error {
+				info, err := admin.DeactivateClient(ctx, args[0])
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID", "KEY", "STATUS", "UPDATED_AT")
+				printRow(w, info.ID, info.Key, info.Status, info.UpdatedAt)
+				return w.Flush()
+			})
+		},
+	}
+}
+
+func newAdminDetachDocumentCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "detach-document <client-id> <collection>/<document>",
+		Short: "Detaches the document from the client.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[1])
+			if err != nil {
+				return err
+			}
+
+			return withAdminClient(func(ctx context.Context, admin *client.AdminClient) error {
+				info, err := admin.DetachDocument(ctx, args[0], docKey)
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID", "KEY", "STATUS", "UPDATED_AT")
+				printRow(w, info.ID, info.Key, info.Status, info.UpdatedAt)
+				return w.Flush()
+			})
+		},
+	}
+}
+
+func newAdminRemoveDocumentCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-document <collection>/<document>",
+		Short: "Removes the document with its changes.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			return withAdminClient(func(ctx context.Context, admin *client.AdminClient) error {
+				info, err := admin.RemoveDocument(ctx, docKey)
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID", "KEY", "SERVER_SEQ")
+				printRow(w, info.ID, info.Key, info.ServerSeq)
+				return w.Flush()
+			})
+		},
+	}
+}
+
+// withAdminClient runs the given function with a client of the Admin service.
+func withAdminClient(f func(ctx context.Context, admin *client.AdminClient) error) error {
+	admin, err := client.NewAdminClient(flagRPCAddr, flagAdminToken)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := admin.Close(); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	return f(context.Background(), admin)
+}
+
+func init() {
+	rootCmd.AddCommand(newAdminCmd())
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hackerwins/yorkie/pkg/log"
 	"github.com/hackerwins/yorkie/yorkie"
 	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
+	"github.com/hackerwins/yorkie/yorkie/housekeeping"
 )
 
 var (
@@ -23,13 +24,27 @@ var (
 			PingTimeoutSec:       5,
 			YorkieDatabase:       "yorkie-meta",
 		},
+		Housekeeping: &housekeeping.Config{
+			ClientDeactivationIntervalSec: 10,
+			ClientTTLSec:                  60,
+			SnapshotIntervalSec:           60,
+			SnapshotThreshold:             1000,
+			ChangeCompactionIntervalSec:   3600,
+			ChangeRetentionSec:            7 * 24 * 60 * 60,
+			ChangeRetentionCount:          1000,
+			DocumentPurgeIntervalSec:      3600,
+			DocumentPurgeDelaySec:         24 * 60 * 60,
+			IndexVerificationIntervalSec:  3600,
+			CandidatesLimit:               100,
+		},
 	}
 	gracefulTimeout = 10 * time.Second
 )
 
 var (
-	flagRPCPort  int
-	flagConfPath string
+	flagRPCPort         int
+	flagConfPath        string
+	flagAgentAdminToken string
 )
 
 func newAgentCmd() *cobra.Command {
@@ -48,6 +63,9 @@ func newAgentCmd() *cobra.Command {
 				}
 				conf = parsed
 			}
+			if flagAgentAdminToken != "" {
+				conf.AdminToken = flagAgentAdminToken
+			}
 
 			r, err := yorkie.New(conf)
 			if err != nil {
@@ -122,5 +140,11 @@ func init() {
 		"",
 		"config path",
 	)
+	cmd.Flags().StringVar(
+		&flagAgentAdminToken,
+		"admin-token",
+		"",
+		"credential of the admin service (default: disabled)",
+	)
 	rootCmd.AddCommand(cmd)
 }
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+)
+
+var (
+	flagMongoConnectionURI string
+	flagMongoDatabase      string
+)
+
+// addBackendFlags adds the flags to connect to the backend directly.
+func addBackendFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(
+		&flagMongoConnectionURI,
+		"mongo-connection-uri",
+		defaultConfig.Mongo.ConnectionURI,
+		"MongoDB's connection URI",
+	)
+	cmd.PersistentFlags().StringVar(
+		&flagMongoDatabase,
+		"mongo-yorkie-database",
+		defaultConfig.Mongo.YorkieDatabase,
+		"yorkie's database name in MongoDB",
+	)
+}
+
+// withBackend connects to the backend and calls the given function with it.
+func withBackend(f func(ctx context.Context, be *backend.Backend) error) error {
+	conf := *defaultConfig.Mongo
+	conf.ConnectionURI = flagMongoConnectionURI
+	conf.YorkieDatabase = flagMongoDatabase
+
+	be, err := backend.New(&conf)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := be.Close(); err != nil {
+			log.Logger.Error(err)
+		}
+	}()
+
+	return f(context.Background(), be)
+}
+
+// parseDocumentKey parses the given argument of "<collection>/<document>".
+func parseDocumentKey(arg string) (*key.Key, error) {
+	splits := strings.SplitN(arg, "/", 2)
+	if len(splits) != 2 || splits[0] == "" || splits[1] == "" {
+		return nil, fmt.Errorf("invalid document key: %s, expected <collection>/<document>", arg)
+	}
+
+	return &key.Key{Collection: splits[0], Document: splits[1]}, nil
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
+	"github.com/hackerwins/yorkie/yorkie/clients"
+	"github.com/hackerwins/yorkie/yorkie/documents"
+)
+
+var (
+	flagLimit int64
+)
+
+func newClientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client [command]",
+		Short: "Inspects clients stored in the backend.",
+	}
+	addBackendFlags(cmd)
+	cmd.AddCommand(newClientListCmd())
+	cmd.AddCommand(newClientGetCmd())
+
+	return cmd
+}
+
+func newClientListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists clients.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				clientInfos, err := clients.List(ctx, be, flagLimit)
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID", "KEY", "STATUS", "DOCUMENTS", "CREATED_AT", "UPDATED_AT")
+				for _, info := range clientInfos {
+					printRow(
+						w,
+						info.ID.Hex(),
+						info.Key,
+						info.Status,
+						len(info.Documents),
+						info.CreatedAt,
+						info.UpdatedAt,
+					)
+				}
+				return w.Flush()
+			})
+		},
+	}
+	cmd.Flags().Int64Var(&flagLimit, "limit", 0, "maximum number of clients (default: all)")
+
+	return cmd
+}
+
+func newClientGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <client-id>",
+		Short: "Shows the client and the checkpoints of its documents.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				info, err := clients.Find(ctx, be, args[0])
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID:", info.ID.Hex())
+				printRow(w, "KEY:", info.Key)
+				printRow(w, "STATUS:", info.Status)
+				printRow(w, "CREATED_AT:", info.CreatedAt)
+				printRow(w, "UPDATED_AT:", info.UpdatedAt)
+				printRow(w)
+
+				docIDs := make([]string, 0, len(info.Documents))
+				for docID := range info.Documents {
+					docIDs = append(docIDs, docID)
+				}
+				sort.Strings(docIDs)
+
+				printRow(w, "DOCUMENT", "STATUS", "SERVER_SEQ", "CLIENT_SEQ")
+				for _, docID := range docIDs {
+					docKey := docID
+					docInfo, err := documents.FindByID(ctx, be, docID)
+					if err == nil {
+						docKey = docInfo.Key
+					} else if err != mongo.ErrDocumentNotFound {
+						return err
+					}
+
+					clientDocInfo := info.Documents[docID]
+					printRow(
+						w,
+						docKey,
+						clientDocInfo.Status,
+						clientDocInfo.ServerSeq,
+						clientDocInfo.ClientSeq,
+					)
+				}
+				return w.Flush()
+			})
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(newClientCmd())
+}
@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hackerwins/yorkie/api/converter"
+	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/documents"
+	"github.com/hackerwins/yorkie/yorkie/types"
+)
+
+var (
+	flagServerSeq     uint64
+	flagTimestamp     string
+	flagFromServerSeq uint64
+	flagToServerSeq   uint64
+	flagCollection    string
+	flagPrefix        string
+	flagSnapshotOnly  bool
+	flagOutput        string
+)
+
+func newDocumentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "document [command]",
+		Short: "Inspects documents stored in the backend.",
+	}
+	addBackendFlags(cmd)
+	cmd.AddCommand(newDocumentListCmd())
+	cmd.AddCommand(newDocumentGetCmd())
+	cmd.AddCommand(newDocumentChangesCmd())
+	cmd.AddCommand(newDocumentSnapshotCmd())
+	cmd.AddCommand(newDocumentDiffCmd())
+	cmd.AddCommand(newDocumentExportCmd())
+	cmd.AddCommand(newDocumentImportCmd())
+
+	return cmd
+}
+
+func newDocumentListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists documents.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				var docInfos []*types.DocInfo
+				var err error
+				if flagCollection != "" {
+					docInfos, err = documents.ListByPrefix(ctx, be, flagCollection, flagPrefix, "", flagLimit)
+				} else {
+					docInfos, err = documents.List(ctx, be, flagLimit)
+				}
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID", "KEY", "SERVER_SEQ", "CREATED_AT", "UPDATED_AT")
+				for _, info := range docInfos {
+					printRow(
+						w,
+						info.ID.Hex(),
+						info.Key,
+						info.ServerSeq,
+						info.CreatedAt,
+						info.UpdatedAt,
+					)
+				}
+				return w.Flush()
+			})
+		},
+	}
+	cmd.Flags().Int64Var(&flagLimit, "limit", 0, "maximum number of documents (default: all)")
+	cmd.Flags().StringVar(&flagCollection, "collection", "", "collection of documents to list")
+	cmd.Flags().StringVar(&flagPrefix, "prefix", "", "prefix of document names, used with --collection")
+
+	return cmd
+}
+
+func newDocumentGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <collection>/<document>",
+		Short: "Shows the document.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				info, err := documents.Find(ctx, be, docKey)
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID:", info.ID.Hex())
+				printRow(w, "KEY:", info.Key)
+				printRow(w, "SERVER_SEQ:", info.ServerSeq)
+				printRow(w, "OWNER:", info.Owner.Hex())
+				printRow(w, "CREATED_AT:", info.CreatedAt)
+				printRow(w, "ACCESSED_AT:", info.AccessedAt)
+				printRow(w, "UPDATED_AT:", info.UpdatedAt)
+				if info.IsRemoved() {
+					printRow(w, "REMOVED_AT:", info.RemovedAt)
+				}
+				return w.Flush()
+			})
+		},
+	}
+}
+
+func newDocumentChangesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changes <collection>/<document>",
+		Short: "Lists the changes of the document.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				changes, err := documents.FindChanges(
+					ctx,
+					be,
+					docKey,
+					flagFromServerSeq,
+					flagToServerSeq,
+					uint32(flagLimit),
+				)
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "SERVER_SEQ", "ACTOR", "CLIENT_SEQ", "LAMPORT", "OPERATIONS", "MESSAGE")
+				for _, c := range changes {
+					printRow(
+						w,
+						c.ServerSeq(),
+						c.ID().Actor().String(),
+						c.ID().ClientSeq(),
+						c.ID().Lamport(),
+						len(c.Operations()),
+						c.Message(),
+					)
+				}
+				return w.Flush()
+			})
+		},
+	}
+	cmd.Flags().Uint64Var(&flagFromServerSeq, "from", 0, "first serverSeq of the range")
+	cmd.Flags().Uint64Var(&flagToServerSeq, "to", 0, "last serverSeq of the range (default: latest)")
+	cmd.Flags().Int64Var(&flagLimit, "limit", 0, "maximum number of changes (default: all)")
+
+	return cmd
+}
+
+func newDocumentSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot <collection>/<document>",
+		Short: "Prints the JSON of the document, optionally at a past point.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[0])
+			if err != nil {
+				return err
+			}
+			if flagServerSeq > 0 && flagTimestamp != "" {
+				return errors.New("--server-seq and --timestamp cannot be used together")
+			}
+
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				var doc *document.Document
+				if flagTimestamp != "" {
+					at, err := time.Parse(time.RFC3339, flagTimestamp)
+					if err != nil {
+						return err
+					}
+					doc, err = documents.MaterializeAt(ctx, be, docKey, at)
+					if err != nil {
+						return err
+					}
+				} else {
+					doc, err = documents.Materialize(ctx, be, docKey, flagServerSeq)
+					if err != nil {
+						return err
+					}
+				}
+
+				fmt.Println(doc.Marshal())
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Uint64Var(
+		&flagServerSeq,
+		"server-seq",
+		0,
+		"serverSeq to materialize the document at (default: latest)",
+	)
+	cmd.Flags().StringVar(
+		&flagTimestamp,
+		"timestamp",
+		"",
+		"time to materialize the document at, in RFC3339",
+	)
+
+	return cmd
+}
+
+func newDocumentDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <collection>/<document>",
+		Short: "Prints the JSON Patch of the document between two serverSeqs.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				diff, err := documents.Diff(ctx, be, docKey, flagFromServerSeq, flagToServerSeq)
+				if err != nil {
+					return err
+				}
+
+				patch, err := diff.JSONPatch()
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(patch))
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Uint64Var(&flagFromServerSeq, "from", 0, "serverSeq to diff from (default: empty document)")
+	cmd.Flags().Uint64Var(&flagToServerSeq, "to", 0, "serverSeq to diff to (default: latest)")
+
+	return cmd
+}
+
+func newDocumentExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <collection>/<document>",
+		Short: "Exports the document with its changes as JSON.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			docKey, err := parseDocumentKey(args[0])
+			if err != nil {
+				return err
+			}
+
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				export, err := documents.Export(ctx, be, docKey, flagSnapshotOnly)
+				if err != nil {
+					return err
+				}
+
+				data, err := converter.DocumentExportToJSON(export)
+				if err != nil {
+					return err
+				}
+
+				if flagOutput == "" {
+					fmt.Println(string(data))
+					return nil
+				}
+				return ioutil.WriteFile(flagOutput, data, 0644)
+			})
+		},
+	}
+	cmd.Flags().BoolVar(
+		&flagSnapshotOnly,
+		"snapshot-only",
+		false,
+		"export only the snapshot of the latest state instead of the changes",
+	)
+	cmd.Flags().StringVarP(&flagOutput, "output", "o", "", "file to write the export to (default: stdout)")
+
+	return cmd
+}
+
+func newDocumentImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file>",
+		Short: "Imports the document exported by the export command.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			export, err := converter.JSONToDocumentExport(data)
+			if err != nil {
+				return err
+			}
+
+			return withBackend(func(ctx context.Context, be *backend.Backend) error {
+				info, err := documents.Import(ctx, be, export)
+				if err != nil {
+					return err
+				}
+
+				w := newTabWriter()
+				printRow(w, "ID:", info.ID.Hex())
+				printRow(w, "KEY:", info.Key)
+				printRow(w, "SERVER_SEQ:", info.ServerSeq)
+				return w.Flush()
+			})
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(newDocumentCmd())
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// newTabWriter creates a writer that aligns the columns of the output.
+func newTabWriter() *tabwriter.Writer {
+	return tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+}
+
+// printRow prints the given columns as a row separated by tabs.
+func printRow(w io.Writer, columns ...interface{}) {
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		values[i] = formatColumn(column)
+	}
+	fmt.Fprintln(w, strings.Join(values, "\t"))
+}
+
+func formatColumn(column interface{}) string {
+	switch v := column.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return "-"
+		}
+		return v.Format(time.RFC3339)
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return v
+	}
+
+	return fmt.Sprintf("%v", column)
+}
@@ -56,8 +56,12 @@ func (c *Change) SetServerSeq(serverSeq uint64) {
 	c.serverSeq = &serverSeq
 }
 
-// ServerSeq returns the serverSeq of this change.
+// ServerSeq returns the serverSeq of this change. It returns 0 if the change
+// has not been stored on the server yet.
 func (c *Change) ServerSeq() uint64 {
+	if c.serverSeq == nil {
+		return 0
+	}
 	return *c.serverSeq
 }
 
@@ -10,6 +10,10 @@ type Pack struct {
 	DocumentKey *key.Key
 	Checkpoint  *checkpoint.Checkpoint
 	Changes     []*Change
+
+	// Snapshot is the encoded state of the document. It is sent instead of
+	// the changes that have already been compacted.
+	Snapshot []byte
 }
 
 // NewPack creates a new instance of Pack.
@@ -0,0 +1,300 @@
+package document
+
+import (
+	gojson "encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hackerwins/yorkie/pkg/document/json"
+	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
+	"github.com/hackerwins/yorkie/pkg/document/proxy"
+	"github.com/hackerwins/yorkie/pkg/document/time"
+)
+
+// DiffType represents the type of an entry of Diff.
+type DiffType string
+
+const (
+	// DiffAdd means that an element is added to an object or an array.
+	DiffAdd DiffType = "add"
+
+	// DiffRemove means that an element is removed from an object or an array.
+	DiffRemove DiffType = "remove"
+
+	// DiffReplace means that the element of a key is replaced with another
+	// element.
+	DiffReplace DiffType = "replace"
+
+	// DiffMove means that an element of an array is moved to another index.
+	DiffMove DiffType = "move"
+
+	// DiffEdit means that a range of a text is edited.
+	DiffEdit DiffType = "edit"
+)
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// DiffEntry is an entry of Diff. Path is the JSON Pointer of the element
+// after the previous entries of the diff are applied.
+type DiffEntry struct {
+	Type DiffType
+
+	// Path is the JSON Pointer of the element the entry is about.
+	Path string
+
+	// From is the JSON Pointer of the element before the move. It is only set
+	// for DiffMove.
+	From string
+
+	// CreatedAt is the creation time of the element added, removed, moved or
+	// edited. For DiffReplace, it is the creation time of the new element.
+	CreatedAt *time.Ticket
+
+	// OldValue and Value are the values of the element before and after the
+	// entry in the form of proxy.ValueOf.
+	OldValue interface{}
+	Value    interface{}
+
+	// EditFrom, EditTo and Content describe the range of the text replaced
+	// with Content. They are only set for DiffEdit and counted in runes.
+	EditFrom int
+	EditTo   int
+	Content  string
+}
+
+// Diff is the difference between two versions of a document.
+type Diff struct {
+	Entries []*DiffEntry
+}
+
+// IsEmpty returns whether the two versions of the document are the same or
+// not.
+func (d *Diff) IsEmpty() bool {
+	return len(d.Entries) == 0
+}
+
+// JSONPatch returns the RFC 6902 JSON Patch of this diff. Text edits are
+// emitted as replace operations with the whole content of the text.
+func (d *Diff) JSONPatch() ([]byte, error) {
+	ops := make([]map[string]interface{}, 0, len(d.Entries))
+	for _, entry := range d.Entries {
+		op := map[string]interface{}{"path": entry.Path}
+		switch entry.Type {
+		case DiffAdd:
+			op["op"] = "add"
+			op["value"] = entry.Value
+		case DiffRemove:
+			op["op"] = "remove"
+		case DiffReplace, DiffEdit:
+			op["op"] = "replace"
+			op["value"] = entry.Value
+		case DiffMove:
+			op["op"] = "move"
+			op["from"] = entry.From
+		}
+		ops = append(ops, op)
+	}
+
+	return gojson.Marshal(ops)
+}
+
+// Compare returns the diff from the given document to the other one.
+// Elements are matched by their creation time, so the elements of arrays are
+// reported as added, removed or moved rather than compared by index. It is
+// meant for the versions of the same document, e.g. the document at two
+// serverSeqs.
+func Compare(from, to *Document) *Diff {
+	diff := &Diff{}
+	diff.compareObject("", from.root.Object(), to.root.Object())
+	return diff
+}
+
+func (d *Diff) compareObject(path string, from, to *json.Object) {
+	fromMembers := from.Members()
+	toMembers := to.Members()
+
+	keys := make([]string, 0, len(fromMembers)+len(toMembers))
+	for k := range fromMembers {
+		keys = append(keys, k)
+	}
+	for k := range toMembers {
+		if _, ok := fromMembers[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		childPath := path + "/" + pointerEscaper.Replace(k)
+		fromElem, inFrom := fromMembers[k]
+		toElem, inTo := toMembers[k]
+
+		switch {
+		case !inTo:
+			d.append(&DiffEntry{
+				Type:      DiffRemove,
+				Path:      childPath,
+				CreatedAt: fromElem.CreatedAt(),
+				OldValue:  proxy.ValueOf(fromElem),
+			})
+		case !inFrom:
+			d.append(&DiffEntry{
+				Type:      DiffAdd,
+				Path:      childPath,
+				CreatedAt: toElem.CreatedAt(),
+				Value:     proxy.ValueOf(toElem),
+			})
+		case fromElem.CreatedAt().Compare(toElem.CreatedAt()) == 0:
+			d.compareElement(childPath, fromElem, toElem)
+		default:
+			oldValue := proxy.ValueOf(fromElem)
+			value := proxy.ValueOf(toElem)
+			if reflect.DeepEqual(oldValue, value) {
+				continue
+			}
+			d.append(&DiffEntry{
+				Type:      DiffReplace,
+				Path:      childPath,
+				CreatedAt: toElem.CreatedAt(),
+				OldValue:  oldValue,
+				Value:     value,
+			})
+		}
+	}
+}
+
+// compareArray compares the elements of the given arrays by their creation
+// time. The removals are emitted first from the back, then the moves to put
+// the remaining elements in order and the insertions from the front, so that
+// each entry is applicable after the previous ones.
+func (d *Diff) compareArray(path string, from, to *json.Array) {
+	fromElems := from.Elements()
+	toElems := to.Elements()
+
+	toByKey := make(map[string]datatype.Element)
+	for _, elem := range toElems {
+		toByKey[elem.CreatedAt().Key()] = elem
+	}
+	fromByKey := make(map[string]datatype.Element)
+	for _, elem := range fromElems {
+		fromByKey[elem.CreatedAt().Key()] = elem
+	}
+
+	current := append([]datatype.Element(nil), fromElems...)
+	for i := len(current) - 1; i >= 0; i-- {
+		elem := current[i]
+		if _, ok := toByKey[elem.CreatedAt().Key()]; ok {
+			continue
+		}
+
+		d.append(&DiffEntry{
+			Type:      DiffRemove,
+			Path:      path + "/" + strconv.Itoa(i),
+			CreatedAt: elem.CreatedAt(),
+			OldValue:  proxy.ValueOf(elem),
+		})
+		current = append(current[:i], current[i+1:]...)
+	}
+
+	var kept []datatype.Element
+	for _, elem := range toElems {
+		if _, ok := fromByKey[elem.CreatedAt().Key()]; ok {
+			kept = append(kept, elem)
+		}
+	}
+	for i, elem := range kept {
+		if current[i].CreatedAt().Compare(elem.CreatedAt()) == 0 {
+			continue
+		}
+
+		j := i + 1
+		for current[j].CreatedAt().Compare(elem.CreatedAt()) != 0 {
+			j++
+		}
+		d.append(&DiffEntry{
+			Type:      DiffMove,
+			Path:      path + "/" + strconv.Itoa(i),
+			From:      path + "/" + strconv.Itoa(j),
+			CreatedAt: elem.CreatedAt(),
+		})
+		moved := current[j]
+		copy(current[i+1:j+1], current[i:j])
+		current[i] = moved
+	}
+
+	for i, elem := range toElems {
+		if _, ok := fromByKey[elem.CreatedAt().Key()]; ok {
+			continue
+		}
+
+		d.append(&DiffEntry{
+			Type:      DiffAdd,
+			Path:      path + "/" + strconv.Itoa(i),
+			CreatedAt: elem.CreatedAt(),
+			Value:     proxy.ValueOf(elem),
+		})
+	}
+
+	for i, elem := range toElems {
+		if fromElem, ok := fromByKey[elem.CreatedAt().Key()]; ok {
+			d.compareElement(path+"/"+strconv.Itoa(i), fromElem, elem)
+		}
+	}
+}
+
+// compareText compares the contents of the given texts and emits the edit of
+// the range between the common prefix and suffix.
+func (d *Diff) compareText(path string, from, to *datatype.Text) {
+	fromRunes := []rune(from.String())
+	toRunes := []rune(to.String())
+
+	prefix := 0
+	for prefix < len(fromRunes) && prefix < len(toRunes) && fromRunes[prefix] == toRunes[prefix] {
+		prefix++
+	}
+	if prefix == len(fromRunes) && prefix == len(toRunes) {
+		return
+	}
+
+	suffix := 0
+	for suffix < len(fromRunes)-prefix && suffix < len(toRunes)-prefix &&
+		fromRunes[len(fromRunes)-1-suffix] == toRunes[len(toRunes)-1-suffix] {
+		suffix++
+	}
+
+	d.append(&DiffEntry{
+		Type:      DiffEdit,
+		Path:      path,
+		CreatedAt: to.CreatedAt(),
+		OldValue:  proxy.Text(from.String()),
+		Value:     proxy.Text(to.String()),
+		EditFrom:  prefix,
+		EditTo:    len(fromRunes) - suffix,
+		Content:   string(toRunes[prefix : len(toRunes)-suffix]),
+	})
+}
+
+// compareElement compares the given elements of the same creation time.
+// Primitives are immutable, so only containers and texts can be different.
+func (d *Diff) compareElement(path string, from, to datatype.Element) {
+	switch from := from.(type) {
+	case *json.Object:
+		if to, ok := to.(*json.Object); ok {
+			d.compareObject(path, from, to)
+		}
+	case *json.Array:
+		if to, ok := to.(*json.Array); ok {
+			d.compareArray(path, from, to)
+		}
+	case *datatype.Text:
+		if to, ok := to.(*datatype.Text); ok {
+			d.compareText(path, from, to)
+		}
+	}
+}
+
+func (d *Diff) append(entry *DiffEntry) {
+	d.Entries = append(d.Entries, entry)
+}
@@ -1,17 +1,25 @@
 package document
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/hackerwins/yorkie/api/converter"
 	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/document/checkpoint"
 	"github.com/hackerwins/yorkie/pkg/document/json"
 	"github.com/hackerwins/yorkie/pkg/document/key"
 	"github.com/hackerwins/yorkie/pkg/document/proxy"
+	"github.com/hackerwins/yorkie/pkg/document/schema"
 	"github.com/hackerwins/yorkie/pkg/document/time"
 	"github.com/hackerwins/yorkie/pkg/log"
 )
 
+var (
+	// ErrDocumentReadOnly is returned when updating a read-only document.
+	ErrDocumentReadOnly = errors.New("document is read-only")
+)
+
 type stateType int
 
 const (
@@ -22,6 +30,10 @@ const (
 	// Attached means that this document is attached to the client.
 	// The actor of the ticket is created with being assigned by the client.
 	Attached stateType = 1
+
+	// Removed means that this document is removed from the agent. It can no
+	// longer be synchronized.
+	Removed stateType = 2
 )
 
 // Document represents a document in MongoDB and contains logical clocks.
@@ -34,6 +46,8 @@ const (
 type Document struct {
 	key          *key.Key
 	state        stateType
+	readOnly     bool
+	schema       *schema.Schema
 	root         *json.Root
 	clone        *json.Object
 	checkpoint   *checkpoint.Checkpoint
@@ -52,6 +66,28 @@ func New(collection, document string) *Document {
 	}
 }
 
+// NewFromSnapshot creates a new instance of Document from the given snapshot
+// which is taken at the given serverSeq.
+func NewFromSnapshot(
+	collection string,
+	document string,
+	serverSeq uint64,
+	snapshot []byte,
+) (*Document, error) {
+	obj, lamport, err := converter.BytesToSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		key:        &key.Key{Collection: collection, Document: document},
+		state:      Detached,
+		root:       json.NewRootFromObject(obj),
+		checkpoint: checkpoint.New(serverSeq, 0),
+		changeID:   change.NewID(0, lamport, time.InitialActorID),
+	}, nil
+}
+
 // Key returns the key of this document.
 func (d *Document) Key() *key.Key {
 	return d.key
@@ -67,11 +103,16 @@ func (d *Document) Update(
 	updater func(root *proxy.ObjectProxy) error,
 	msgAndArgs ...interface{},
 ) error {
+	if d.readOnly {
+		log.Logger.Error(ErrDocumentReadOnly)
+		return ErrDocumentReadOnly
+	}
+
 	if d.clone == nil {
 		d.clone = d.root.Object().Deepcopy().(*json.Object)
 	}
 
-	ctx := change.NewContext(d.changeID.Next(), messageFromMsgAndArgs(msgAndArgs))
+	ctx := change.NewContext(d.changeID.Next(), messageFromMsgAndArgs(msgAndArgs...))
 	if err := updater(proxy.ProxyObject(ctx, d.clone)); err != nil {
 		// drop copy because it is contaminated.
 		d.clone = nil
@@ -79,6 +120,15 @@ func (d *Document) Update(
 		return err
 	}
 
+	if d.schema != nil {
+		if err := d.schema.Validate(d.clone); err != nil {
+			// drop copy because it is contaminated.
+			d.clone = nil
+			log.Logger.Error(err)
+			return err
+		}
+	}
+
 	if ctx.HasOperations() {
 		c := ctx.ToChange()
 		if err := c.Execute(d.root); err != nil {
@@ -97,8 +147,16 @@ func (d *Document) HasLocalChanges() bool {
 	return len(d.localChanges) > 0
 }
 
-// ApplyChangePack applies the given change pack into this document.
+// ApplyChangePack applies the given change pack into this document. If the
+// pack has a snapshot, the root is replaced with it before the changes in the
+// pack are applied.
 func (d *Document) ApplyChangePack(pack *change.Pack) error {
+	if len(pack.Snapshot) > 0 {
+		if err := d.applySnapshot(pack.Snapshot); err != nil {
+			return err
+		}
+	}
+
 	for _, c := range pack.Changes {
 		d.changeID = d.changeID.Sync(c.ID())
 		if err := c.Execute(d.root); err != nil {
@@ -114,11 +172,37 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 	return nil
 }
 
+// applySnapshot replaces the root with the given snapshot. The local changes
+// that have not been sent yet are executed again on the new root.
+func (d *Document) applySnapshot(snapshot []byte) error {
+	obj, lamport, err := converter.BytesToSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	d.root = json.NewRootFromObject(obj)
+	for _, c := range d.localChanges {
+		if err := c.Execute(d.root); err != nil {
+			return err
+		}
+	}
+	d.changeID = d.changeID.Sync(change.NewID(0, lamport, time.InitialActorID))
+
+	return nil
+}
+
 // Marshal returns the JSON encoding of this document.
 func (d *Document) Marshal() string {
 	return d.root.Object().Marshal()
 }
 
+// Snapshot returns the encoded state of this document. Unlike Marshal, it
+// keeps the metadata of CRDT so that remote changes can be applied to the
+// document restored from it.
+func (d *Document) Snapshot() ([]byte, error) {
+	return converter.SnapshotToBytes(d.root.Object(), d.changeID.Lamport())
+}
+
 // FlushChangePack flushes the local change pack to send to the remote server.
 func (d *Document) FlushChangePack() *change.Pack {
 	changes := d.localChanges
@@ -128,6 +212,15 @@ func (d *Document) FlushChangePack() *change.Pack {
 	return change.NewPack(d.key, cp, changes)
 }
 
+// RestoreChangePack puts the changes of the given pack flushed by
+// FlushChangePack back to the local changes when the pack was not accepted
+// by the remote server. They are sent again with the next pack.
+func (d *Document) RestoreChangePack(pack *change.Pack) {
+	changes := make([]*change.Change, 0, len(pack.Changes)+len(d.localChanges))
+	changes = append(changes, pack.Changes...)
+	d.localChanges = append(changes, d.localChanges...)
+}
+
 // SetActor sets actor into this document. This is also applied in the local
 // changes the document has.
 func (d *Document) SetActor(actor *time.ActorID) {
@@ -152,6 +245,45 @@ func (d *Document) IsAttached() bool {
 	return d.state == Attached
 }
 
+// IsRemoved returns the whether this document is removed or not.
+func (d *Document) IsRemoved() bool {
+	return d.state == Removed
+}
+
+// SetReadOnly sets whether this document is read-only or not. The read-only
+// document can not be updated locally, but remote changes are still applied.
+func (d *Document) SetReadOnly(readOnly bool) {
+	d.readOnly = readOnly
+}
+
+// IsReadOnly returns the whether this document is read-only or not.
+func (d *Document) IsReadOnly() bool {
+	return d.readOnly
+}
+
+// SetSchema sets the schema that this document should conform to. If the
+// schema is set, Update returns schema.ViolationError instead of applying the
+// changes that violate it.
+func (d *Document) SetSchema(s *schema.Schema) {
+	d.schema = s
+}
+
+// Schema returns the schema of this document. It is nil if no schema is set.
+func (d *Document) Schema() *schema.Schema {
+	return d.schema
+}
+
+// ValidateSchema returns schema.ViolationError if this document does not
+// conform to its schema. It is used to check the remote changes applied by
+// ApplyChangePack, which are not validated.
+func (d *Document) ValidateSchema() error {
+	if d.schema == nil {
+		return nil
+	}
+
+	return d.schema.Validate(d.root.Object())
+}
+
 func messageFromMsgAndArgs(msgAndArgs ...interface{}) string {
 	if len(msgAndArgs) == 0 {
 		return ""
@@ -1,14 +1,20 @@
 package document_test
 
 import (
+	gojson "encoding/json"
 	"errors"
 	"testing"
+	gotime "time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hackerwins/yorkie/api/converter"
 	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/document/checkpoint"
 	"github.com/hackerwins/yorkie/pkg/document/proxy"
+	"github.com/hackerwins/yorkie/pkg/document/schema"
+	"github.com/hackerwins/yorkie/pkg/document/time"
 )
 
 var (
@@ -148,6 +154,28 @@ func TestDocument(t *testing.T) {
 		}
 	})
 
+	t.Run("update created elements test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		if err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "ABCD")
+			root.SetNewArray("k2").AddInteger(1).AddInteger(2)
+			root.SetNewObject("k3").SetString("k3.1", "v")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+
+		if err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(0, 1, "1")
+			root.GetArray("k2").Remove(0)
+			root.GetObject("k3").SetString("k3.1", "w")
+			return nil
+		}); err != nil {
+			t.Error(err)
+		}
+		assert.Equal(t, `{"k1":"1BCD","k2":[2],"k3":{"k3.1":"w"}}`, doc.Marshal())
+	})
+
 	t.Run("rollback test", func(t *testing.T) {
 		doc := document.New("c1", "d1")
 
@@ -175,4 +203,888 @@ func TestDocument(t *testing.T) {
 		}
 		assert.Equal(t, `{"k1":[1,2,3,4,5]}`, doc.Marshal())
 	})
+
+	t.Run("read-only test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		doc.SetReadOnly(true)
+		assert.True(t, doc.IsReadOnly())
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.Equal(t, document.ErrDocumentReadOnly, err)
+		assert.Equal(t, "{}", doc.Marshal())
+		assert.False(t, doc.HasLocalChanges())
+	})
+
+	t.Run("snapshot test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddString("1").AddString("2").AddString("3")
+			root.SetNewText("k3").Edit(0, 0, "ABCD")
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.Remove("k1")
+			root.GetArray("k2").Remove(1)
+			root.GetText("k3").Edit(1, 3, "12")
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		snapshot, err := doc1.Snapshot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc2, err := document.NewFromSnapshot("c1", "d1", 3, snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+		assert.Equal(t, uint64(3), doc2.Checkpoint().ServerSeq)
+
+		// changes made after the snapshot can be applied to the restored one.
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k2").AddString("4")
+			root.GetText("k3").Edit(2, 4, "")
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		pack := doc1.FlushChangePack()
+		if err := doc2.ApplyChangePack(change.NewPack(
+			pack.DocumentKey,
+			checkpoint.New(4, 0),
+			pack.Changes[len(pack.Changes)-1:],
+		)); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("apply snapshot test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		if err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1).AddInteger(2)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		snapshot, err := doc1.Snapshot()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the local changes are kept on the root replaced with the snapshot.
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		view := doc2.Root()
+		pack := change.NewPack(doc2.Key(), checkpoint.New(1, 0), nil)
+		pack.Snapshot = snapshot
+		if err := doc2.ApplyChangePack(pack); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, `{"k1":[1,2],"k2":"v2"}`, doc2.Marshal())
+		assert.Equal(t, doc2.Marshal(), view.Marshal())
+		assert.Equal(t, uint64(1), doc2.Checkpoint().ServerSeq)
+
+		if err := doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").AddInteger(3)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, `{"k1":[1,2,3],"k2":"v2"}`, doc2.Marshal())
+	})
+	t.Run("set values test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		doc.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			if err := root.SetValues(map[string]interface{}{
+				"k1": map[string]interface{}{"k1.1": true, "k1.2": nil},
+				"k2": []interface{}{1, int64(1 << 40), 1.5, float64(2), "v"},
+				"k3": proxy.Text("hello"),
+			}); err != nil {
+				return err
+			}
+			if err := root.SetValue("k4", gojson.RawMessage(`{"k4.1":[1,{"k4.2":"v"}]}`)); err != nil {
+				return err
+			}
+			return root.GetArray("k2").AddValues(proxy.Text("world"), []interface{}{})
+		})
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"k1":{"k1.1":true,"k1.2":null},"k2":[1,1099511627776,1.500000,2,"v","world",[]],"k3":"hello","k4":{"k4.1":[1,{"k4.2":"v"}]}}`,
+			doc.Marshal(),
+		)
+
+		// marked strings are built as Text which can be edited.
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k3").Edit(5, 5, "!")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		snapshot, err := doc.Snapshot()
+		assert.NoError(t, err)
+		restored, err := document.NewFromSnapshot("c1", "d1", 1, snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), restored.Marshal())
+
+		// floats out of the range of int64 are kept as doubles.
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.SetValue("k6", float64(1<<63))
+		})
+		assert.NoError(t, err)
+		d, ok := doc.Root().GetDouble("k6")
+		assert.True(t, ok)
+		assert.Equal(t, float64(1<<63), d)
+
+		// the same JSON builds the same elements whether numbers are decoded
+		// as float64 or as json.Number.
+		data := []byte(`{"a":1.0,"b":1e3,"c":1.5,"d":9223372036854775808}`)
+		var decoded interface{}
+		assert.NoError(t, gojson.Unmarshal(data, &decoded))
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			if err := root.SetValue("k7", decoded); err != nil {
+				return err
+			}
+			return root.SetValue("k8", gojson.RawMessage(data))
+		})
+		assert.NoError(t, err)
+		for _, k := range []string{"a", "b", "c", "d"} {
+			assert.Equal(t, doc.Root().Get("k7."+k).Value(), doc.Root().Get("k8."+k).Value(), k)
+		}
+		assert.Equal(t, 1, doc.Root().Get("k8.a").Value())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.SetValue("k5", struct{}{})
+		})
+		assert.Equal(t, proxy.ErrUnsupportedValue, err)
+	})
+	t.Run("insert test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.SetNewArray("k1").AddInteger(1).AddInteger(3)
+			if err := arr.InsertAt(1, 2); err != nil {
+				return err
+			}
+			if err := arr.InsertAt(0, proxy.Text("t")); err != nil {
+				return err
+			}
+			if err := arr.InsertAfter(arr.Get(3), []interface{}{4}); err != nil {
+				return err
+			}
+			return arr.InsertAfter(nil, map[string]interface{}{"k1.1": "v"})
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[{"k1.1":"v"},"t",1,2,3,[4]]}`, doc1.Marshal())
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			return root.GetArray("k1").InsertAt(2, 0)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[{"k1.1":"v"},"t",0,1,2,3,[4]]}`, doc1.Marshal())
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			return root.GetArray("k1").InsertAt(8, 0)
+		})
+		assert.Equal(t, proxy.ErrIndexOutOfRange, err)
+
+		// the inserted elements are applied in the same position remotely.
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+	t.Run("move test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0).AddInteger(1).AddInteger(2).AddInteger(3)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			if err := arr.MoveFront(arr.Get(3)); err != nil {
+				return err
+			}
+			if err := arr.MoveLast(arr.Get(1)); err != nil {
+				return err
+			}
+			return arr.MoveBefore(arr.Get(0), arr.Get(2))
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[2,3,1,0]}`, doc1.Marshal())
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveAfter(arr.Get(3), arr.Get(1))
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[2,1,0,3]}`, doc1.Marshal())
+
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// concurrent moves of the same element converge to the latest one.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveLast(arr.Get(0))
+		})
+		assert.NoError(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveAfter(arr.Get(1), arr.Get(0))
+		})
+		assert.NoError(t, err)
+		pack1 := flushChangePack(t, doc1)
+		pack2 := flushChangePack(t, doc2)
+		assert.NoError(t, doc1.ApplyChangePack(pack2))
+		assert.NoError(t, doc2.ApplyChangePack(pack1))
+		assert.Equal(t, `{"k1":[1,2,0,3]}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// the moved positions are kept in the snapshot.
+		snapshot, err := doc1.Snapshot()
+		assert.NoError(t, err)
+		doc3, err := document.NewFromSnapshot("c1", "d1", 1, snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, doc1.Marshal(), doc3.Marshal())
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveFront(root.GetArray("k1").Get(5))
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("concurrent move test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0).AddInteger(1).AddInteger(2).AddInteger(3)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+
+		// an element inserted after a concurrently moved element stays at the
+		// position it was inserted at.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveLast(arr.Get(1))
+		})
+		assert.NoError(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.InsertAfter(arr.Get(1), 4)
+		})
+		assert.NoError(t, err)
+		pack1 := flushChangePack(t, doc1)
+		pack2 := flushChangePack(t, doc2)
+		assert.NoError(t, doc1.ApplyChangePack(pack2))
+		assert.NoError(t, doc2.ApplyChangePack(pack1))
+		assert.Equal(t, `{"k1":[0,4,2,3,1]}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// concurrent moves of different elements converge even if one of them
+		// is moved after the other.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveAfter(arr.Get(3), arr.Get(0))
+		})
+		assert.NoError(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			return arr.MoveFront(arr.Get(3))
+		})
+		assert.NoError(t, err)
+		snapshot, err := doc1.Snapshot()
+		assert.NoError(t, err)
+		pack1 = flushChangePack(t, doc1)
+		pack2 = flushChangePack(t, doc2)
+		assert.NoError(t, doc1.ApplyChangePack(pack2))
+		assert.NoError(t, doc2.ApplyChangePack(pack1))
+		assert.Equal(t, `{"k1":[3,4,2,0,1]}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// the positions the elements have been moved away from are kept in the
+		// snapshot, so the changes that refer to them can be applied.
+		doc3, err := document.NewFromSnapshot("c1", "d1", 1, snapshot)
+		assert.NoError(t, err)
+		assert.NoError(t, doc3.ApplyChangePack(pack2))
+		assert.Equal(t, doc1.Marshal(), doc3.Marshal())
+	})
+
+	t.Run("nested array elements test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.SetNewArray("k1")
+			arr.AddNewObject().SetString("k1.1", "v1")
+			arr.AddNewArray().AddInteger(1)
+			arr.AddNewText().Edit(0, 0, "ab")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[{"k1.1":"v1"},[1],"ab"]}`, doc1.Marshal())
+
+		// the elements added in the previous update can be edited by index.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.GetObject(0).SetString("k1.2", "v2")
+			arr.GetArray(1).AddInteger(2)
+			arr.GetText(2).Edit(1, 1, "c")
+			assert.Nil(t, arr.GetObject(3))
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":[{"k1.1":"v1","k1.2":"v2"},[1,2],"acb"]}`, doc1.Marshal())
+
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("primitive getters test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		date := gotime.Date(2020, 1, 1, 0, 0, 0, 0, gotime.UTC)
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetBool("bool", true)
+			root.SetInteger("int", 1)
+			root.SetLong("long", 1<<40)
+			root.SetDouble("double", 1.5)
+			root.SetString("str", "v")
+			root.SetBytes("bytes", []byte{1})
+			root.SetDate("date", date)
+			root.SetNewArray("arr").AddString("a1").AddInteger(2)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			b, ok := root.GetBool("bool")
+			assert.True(t, ok)
+			assert.True(t, b)
+			i, ok := root.GetInteger("int")
+			assert.True(t, ok)
+			assert.Equal(t, 1, i)
+			l, ok := root.GetLong("long")
+			assert.True(t, ok)
+			assert.Equal(t, int64(1<<40), l)
+			d, ok := root.GetDouble("double")
+			assert.True(t, ok)
+			assert.Equal(t, 1.5, d)
+			str, ok := root.GetString("str")
+			assert.True(t, ok)
+			assert.Equal(t, "v", str)
+			bytes, ok := root.GetBytes("bytes")
+			assert.True(t, ok)
+			assert.Equal(t, []byte{1}, bytes)
+			dt, ok := root.GetDate("date")
+			assert.True(t, ok)
+			assert.True(t, date.Equal(dt))
+
+			// integers are widened, but other types are not converted.
+			l, ok = root.GetLong("int")
+			assert.True(t, ok)
+			assert.Equal(t, int64(1), l)
+			d, ok = root.GetDouble("int")
+			assert.True(t, ok)
+			assert.Equal(t, float64(1), d)
+			_, ok = root.GetInteger("long")
+			assert.False(t, ok)
+			_, ok = root.GetString("int")
+			assert.False(t, ok)
+			_, ok = root.GetString("arr")
+			assert.False(t, ok)
+			_, ok = root.GetString("none")
+			assert.False(t, ok)
+
+			arr := root.GetArray("arr")
+			str, ok = arr.GetString(0)
+			assert.True(t, ok)
+			assert.Equal(t, "a1", str)
+			i, ok = arr.GetInteger(1)
+			assert.True(t, ok)
+			assert.Equal(t, 2, i)
+			_, ok = arr.GetInteger(2)
+			assert.False(t, ok)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("root view test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.SetValues(map[string]interface{}{
+				"a": map[string]interface{}{
+					"b": []interface{}{0, 1, 2, map[string]interface{}{"c": "v"}},
+				},
+				"t": proxy.Text("text"),
+				"n": 1.5,
+			})
+		})
+		assert.NoError(t, err)
+
+		root := doc.Root()
+		assert.True(t, root.IsObject())
+		assert.Equal(t, []string{"a", "n", "t"}, root.Keys())
+		assert.Equal(t, `{"c":"v"}`, root.Get("a.b[3]").Marshal())
+		assert.True(t, root.Get("a.b").IsArray())
+		assert.Equal(t, 4, root.Get("a.b").Len())
+		assert.Equal(t, 1, root.Get("a.b[3]").Get("").Len())
+		assert.Equal(t, "v", root.Get("a.b[3].c").Value())
+
+		str, ok := root.GetString("a.b[3].c")
+		assert.True(t, ok)
+		assert.Equal(t, "v", str)
+		str, ok = root.GetString("t")
+		assert.True(t, ok)
+		assert.Equal(t, "text", str)
+		i, ok := root.Get("a.b").GetInteger("[2]")
+		assert.True(t, ok)
+		assert.Equal(t, 2, i)
+		d, ok := root.GetDouble("n")
+		assert.True(t, ok)
+		assert.Equal(t, 1.5, d)
+
+		var values []interface{}
+		for _, elem := range root.Get("a.b").Elements()[:3] {
+			values = append(values, elem.Value())
+		}
+		assert.Equal(t, []interface{}{0, 1, 2}, values)
+
+		for _, path := range []string{"x", "a.b[4]", "a[0]", "a.b.c", "a..b", ".a", "a.", "a.b[x]", "a.b[3]c"} {
+			assert.Nil(t, root.Get(path), path)
+		}
+		_, ok = root.GetInteger("a.b[3].c")
+		assert.False(t, ok)
+
+		// the view reflects the later changes without Update on it.
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("a").GetArray("b").GetObject(3).SetString("c", "w")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "w", root.Get("a.b[3].c").Value())
+
+		// the views of array elements keep pointing at the same elements after
+		// remote changes insert or move elements before them.
+		doc.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc)))
+
+		elems := root.Get("a.b").Elements()
+		second := root.Get("a.b[1]")
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetObject("a").GetArray("b")
+			if err := arr.InsertAt(0, -1); err != nil {
+				return err
+			}
+			return arr.MoveFront(arr.Get(3))
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, doc.ApplyChangePack(flushChangePack(t, doc2)))
+		assert.Equal(t, `[2,-1,0,1,{"c":"w"}]`, root.Get("a.b").Marshal())
+		assert.Equal(t, 0, elems[0].Value())
+		assert.Equal(t, 2, elems[2].Value())
+		assert.Equal(t, 1, second.Value())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("a").GetArray("b").Remove(3)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "null", second.Marshal())
+		assert.Equal(t, 4, root.Get("a.b").Len())
+		assert.Equal(t, 3, root.Len())
+	})
+
+	t.Run("set/remove path test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			if err := root.SetPath("/settings/theme/color", "red"); err != nil {
+				return err
+			}
+			if err := root.SetPath("/list", []interface{}{1, 2}); err != nil {
+				return err
+			}
+			if err := root.SetPath("/list/-", 3); err != nil {
+				return err
+			}
+			if err := root.SetPath("/list/0", map[string]interface{}{}); err != nil {
+				return err
+			}
+			if err := root.SetPath("/list/0/a~1b", "v"); err != nil {
+				return err
+			}
+			return root.SetPath("/m~0n", true)
+		})
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"list":[{"a/b":"v"},2,3],"m~n":true,"settings":{"theme":{"color":"red"}}}`,
+			doc1.Marshal(),
+		)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			if err := root.RemovePath("/settings/theme/color"); err != nil {
+				return err
+			}
+			return root.RemovePath("/list/1")
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"list":[{"a/b":"v"},3],"m~n":true,"settings":{"theme":{}}}`, doc1.Marshal())
+
+		for path, expected := range map[string]error{
+			"":                     proxy.ErrInvalidPath,
+			"settings":             proxy.ErrInvalidPath,
+			"/list/01":             proxy.ErrInvalidPath,
+			"/list/5":              proxy.ErrIndexOutOfRange,
+			"/m~0n/x":              proxy.ErrInvalidPath,
+			"/settings/theme/none": proxy.ErrPathNotFound,
+			"/none/x":              proxy.ErrPathNotFound,
+		} {
+			err = doc1.Update(func(root *proxy.ObjectProxy) error {
+				if expected == proxy.ErrPathNotFound {
+					return root.RemovePath(path)
+				}
+				return root.SetPath(path, 1)
+			})
+			assert.Equal(t, expected, err, path)
+		}
+
+		// the changes by paths are the normal operations.
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("json patch test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := document.ApplyPatch(doc, []byte(`[
+			{"op": "add", "path": "/a", "value": {"b": [1, 2, 3]}},
+			{"op": "add", "path": "/a/b/1", "value": "x"},
+			{"op": "add", "path": "/a/b/-", "value": {"c": true}},
+			{"op": "remove", "path": "/a/b/0"},
+			{"op": "replace", "path": "/a/b/0", "value": 1.5},
+			{"op": "copy", "from": "/a/b", "path": "/d"},
+			{"op": "move", "from": "/a/b/3", "path": "/e"},
+			{"op": "test", "path": "/e", "value": {"c": true}},
+			{"op": "test", "path": "/d", "value": [1.5, 2, 3, {"c": true}]}
+		]`))
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"a":{"b":[1.500000,2,3]},"d":[1.500000,2,3,{"c":true}],"e":{"c":true}}`,
+			doc.Marshal(),
+		)
+
+		// a failing operation aborts the whole patch.
+		err = document.ApplyPatch(doc, []byte(`[
+			{"op": "remove", "path": "/d"},
+			{"op": "test", "path": "/e/c", "value": false}
+		]`))
+		assert.Equal(t, document.ErrPatchTestFailed, err)
+		err = document.ApplyPatch(doc, []byte(`[
+			{"op": "remove", "path": "/d"},
+			{"op": "replace", "path": "/x", "value": 1}
+		]`))
+		assert.Equal(t, proxy.ErrPathNotFound, err)
+		err = document.ApplyPatch(doc, []byte(`[{"op": "unknown", "path": "/d"}]`))
+		assert.Equal(t, document.ErrInvalidPatch, err)
+		assert.Equal(
+			t,
+			`{"a":{"b":[1.500000,2,3]},"d":[1.500000,2,3,{"c":true}],"e":{"c":true}}`,
+			doc.Marshal(),
+		)
+		assert.True(t, doc.HasLocalChanges())
+	})
+
+	t.Run("concurrent json patch move test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := document.ApplyPatch(doc1, []byte(`[
+			{"op": "add", "path": "/a", "value": [0, 1, 2, {"b": 3}]}
+		]`))
+		assert.NoError(t, err)
+
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+
+		// a move within an array keeps the element, so the replicas moving the
+		// same element concurrently converge without duplicating it.
+		assert.NoError(t, document.ApplyPatch(doc1, []byte(`[
+			{"op": "move", "from": "/a/3", "path": "/a/0"},
+			{"op": "move", "from": "/a/1", "path": "/a/3"}
+		]`)))
+		assert.Equal(t, `{"a":[{"b":3},1,2,0]}`, doc1.Marshal())
+		assert.NoError(t, document.ApplyPatch(doc2, []byte(`[
+			{"op": "move", "from": "/a/3", "path": "/a/-"},
+			{"op": "move", "from": "/a/0", "path": "/a/2"}
+		]`)))
+		assert.Equal(t, `{"a":[1,2,0,{"b":3}]}`, doc2.Marshal())
+
+		pack1 := flushChangePack(t, doc1)
+		pack2 := flushChangePack(t, doc2)
+		assert.NoError(t, doc1.ApplyChangePack(pack2))
+		assert.NoError(t, doc2.ApplyChangePack(pack1))
+		assert.Equal(t, `{"a":[{"b":3},1,2,0]}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// the moved object keeps its identity, so it can still be edited.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			return root.SetPath("/a/0/b", 4)
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		err = document.ApplyPatch(doc1, []byte(`[{"op": "move", "from": "/a/0", "path": "/a/4"}]`))
+		assert.Equal(t, proxy.ErrIndexOutOfRange, err)
+	})
+
+	t.Run("merge patch test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := document.ApplyMergePatch(doc, []byte(`{
+			"title": "Goodbye!",
+			"author": {"givenName": "John", "familyName": "Doe"},
+			"tags": ["example", "sample"],
+			"content": "This will be unchanged"
+		}`))
+		assert.NoError(t, err)
+
+		err = document.ApplyMergePatch(doc, []byte(`{
+			"title": "Hello!",
+			"phoneNumber": "+01-123-456-7890",
+			"author": {"familyName": null},
+			"tags": ["example"],
+			"content": {"a": {"b": null}}
+		}`))
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"author":{"givenName":"John"},"content":{"a":{}},"phoneNumber":"+01-123-456-7890","tags":["example"],"title":"Hello!"}`,
+			doc.Marshal(),
+		)
+
+		err = document.ApplyMergePatch(doc, []byte(`["not", "object"]`))
+		assert.Equal(t, document.ErrInvalidPatch, err)
+	})
+
+	t.Run("diff test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetInteger("k2", 2)
+			root.SetNewObject("k3").SetBool("a", true)
+			root.SetNewArray("k4").AddInteger(0).AddInteger(1).AddInteger(2).AddInteger(3).AddInteger(4)
+			root.SetNewText("k5").Edit(0, 0, "Hello world")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		snapshot, err := doc.Snapshot()
+		assert.NoError(t, err)
+		from, err := document.NewFromSnapshot("c1", "d1", 0, snapshot)
+		assert.NoError(t, err)
+		assert.True(t, document.Compare(from, doc).IsEmpty())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Remove("k1")
+			root.SetInteger("k2", 3)
+			root.GetObject("k3").SetString("b", "c")
+			arr := root.GetArray("k4")
+			arr.Remove(1)
+			if err := arr.MoveFront(arr.Get(3)); err != nil {
+				return err
+			}
+			if err := arr.InsertAt(2, 5); err != nil {
+				return err
+			}
+			root.GetText("k5").Edit(6, 11, "yorkie")
+			root.SetString("k6/~", "v6")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"k2":3,"k3":{"a":true,"b":"c"},"k4":[4,0,5,2,3],"k5":"Hello yorkie","k6/~":"v6"}`,
+			doc.Marshal(),
+		)
+
+		diff := document.Compare(from, doc)
+		var types []document.DiffType
+		for _, entry := range diff.Entries {
+			types = append(types, entry.Type)
+		}
+		assert.Equal(t, []document.DiffType{
+			document.DiffRemove,
+			document.DiffReplace,
+			document.DiffAdd,
+			document.DiffRemove,
+			document.DiffMove,
+			document.DiffAdd,
+			document.DiffEdit,
+			document.DiffAdd,
+		}, types)
+
+		edit := diff.Entries[6]
+		assert.Equal(t, "/k5", edit.Path)
+		assert.Equal(t, 6, edit.EditFrom)
+		assert.Equal(t, 11, edit.EditTo)
+		assert.Equal(t, "yorkie", edit.Content)
+
+		patch, err := diff.JSONPatch()
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`[{"op":"remove","path":"/k1"},`+
+				`{"op":"replace","path":"/k2","value":3},`+
+				`{"op":"add","path":"/k3/b","value":"c"},`+
+				`{"op":"remove","path":"/k4/1"},`+
+				`{"from":"/k4/3","op":"move","path":"/k4/0"},`+
+				`{"op":"add","path":"/k4/2","value":5},`+
+				`{"op":"replace","path":"/k5","value":"Hello yorkie"},`+
+				`{"op":"add","path":"/k6~1~0","value":"v6"}]`,
+			string(patch),
+		)
+
+		assert.NoError(t, document.ApplyPatch(from, patch))
+		assert.Equal(t, doc.Marshal(), from.Marshal())
+	})
+
+	t.Run("schema test", func(t *testing.T) {
+		_, err := schema.Parse([]byte(`{"type": "unknown"}`))
+		assert.Equal(t, schema.ErrInvalidSchema, err)
+
+		s, err := schema.Parse([]byte(`{
+			"type": "object",
+			"required": ["title"],
+			"additionalProperties": false,
+			"properties": {
+				"title": {"type": "string"},
+				"score": {"type": "number"},
+				"author": {
+					"type": "object",
+					"properties": {"name": {"type": "string"}}
+				},
+				"tags": {"type": "array", "items": {"type": "string"}}
+			}
+		}`))
+		assert.NoError(t, err)
+
+		doc := document.New("c1", "d1")
+		doc.SetSchema(s)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("score", 1)
+			return nil
+		})
+		assert.Equal(t, `schema violation at /: missing required property "title"`, err.Error())
+		assert.Equal(t, "{}", doc.Marshal())
+		assert.False(t, doc.HasLocalChanges())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("title").Edit(0, 0, "Hello")
+			root.SetDouble("score", 1.5)
+			root.SetNewObject("author").SetString("name", "Alice")
+			root.SetNewArray("tags").AddString("a")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("tags").AddString("b").AddInteger(3)
+			return nil
+		})
+		violation, ok := err.(*schema.ViolationError)
+		assert.True(t, ok)
+		assert.Equal(t, "/tags/2", violation.Path)
+		assert.Equal(t, "expected string but got integer", violation.Reason)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("author").SetBool("name", true)
+			return nil
+		})
+		assert.Equal(t, "schema violation at /author/name: expected string but got boolean", err.Error())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("extra", "v")
+			return nil
+		})
+		assert.Equal(t, `schema violation at /: unexpected property "extra"`, err.Error())
+
+		assert.Equal(
+			t,
+			`{"author":{"name":"Alice"},"score":1.500000,"tags":["a"],"title":"Hello"}`,
+			doc.Marshal(),
+		)
+		assert.NoError(t, doc.ValidateSchema())
+	})
+
+	t.Run("restore change pack test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1)
+			return nil
+		})
+		assert.NoError(t, err)
+		pack := doc1.FlushChangePack()
+		assert.False(t, doc1.HasLocalChanges())
+
+		// the restored changes are sent before the changes made afterwards.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").AddInteger(2)
+			return nil
+		})
+		assert.NoError(t, err)
+		doc1.RestoreChangePack(pack)
+		assert.True(t, doc1.HasLocalChanges())
+
+		doc2 := document.New("c1", "d1")
+		assert.NoError(t, doc2.ApplyChangePack(flushChangePack(t, doc1)))
+		assert.Equal(t, `{"k1":[1,2]}`, doc2.Marshal())
+	})
+}
+
+// flushChangePack flushes the local change pack of the given document through
+// the protobuf encoding, so that the elements are not shared between
+// documents.
+func flushChangePack(t *testing.T, doc *document.Document) *change.Pack {
+	pack, err := converter.FromChangePack(converter.ToChangePack(doc.FlushChangePack()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pack
 }
@@ -1,6 +1,8 @@
 package json
 
 import (
+	time2 "time"
+
 	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
 	"github.com/hackerwins/yorkie/pkg/document/time"
 )
@@ -31,6 +33,12 @@ func (a *Array) Get(idx int) datatype.Element {
 	return a.elements.Get(idx)
 }
 
+// IndexOf returns the index of the given element. It returns -1 if the
+// element is not in this Array or removed.
+func (a *Array) IndexOf(createdAt *time.Ticket) int {
+	return a.elements.IndexOf(createdAt)
+}
+
 // Remove removes the element of the given index.
 func (a *Array) Remove(idx int) datatype.Element {
 	removed := a.elements.Get(idx)
@@ -46,6 +54,11 @@ func (a *Array) Elements() []datatype.Element {
 	return a.elements.Elements()
 }
 
+// AllNodes returns all the nodes of this Array including the removed ones.
+func (a *Array) AllNodes() []*datatype.RGANode {
+	return a.elements.AllNodes()
+}
+
 // Marshal returns the JSON encoding of this Array.
 func (a *Array) Marshal() string {
 	return a.elements.Marshal()
@@ -55,8 +68,10 @@ func (a *Array) Marshal() string {
 func (a *Array) Deepcopy() datatype.Element {
 	elements := datatype.NewRGA()
 
-	for _, elem := range a.elements.Elements() {
-		elements.Add(elem.Deepcopy())
+	for _, node := range a.elements.AllNodes() {
+		if !node.IsRemoved() {
+			elements.AddNode(node.PositionedAt(), node.Element().Deepcopy())
+		}
 	}
 
 	return NewArray(elements, a.createdAt)
@@ -67,14 +82,37 @@ func (a *Array) CreatedAt() *time.Ticket {
 	return a.createdAt
 }
 
-// LastCreatedAt returns the creation time of the last element.
+// LastCreatedAt returns the position of the last element.
 func (a *Array) LastCreatedAt() *time.Ticket {
 	return a.elements.LastCreatedAt()
 }
 
-// InsertAfter inserts the given element after the given previous element.
-func (a *Array) InsertAfter(prevCreatedAt *time.Ticket, element datatype.Element) {
-	a.elements.InsertAfter(prevCreatedAt, element)
+// InsertAfter inserts the given element after the given previous position.
+func (a *Array) InsertAfter(prevCreatedAt *time.Ticket, element datatype.Element) error {
+	return a.elements.InsertAfter(prevCreatedAt, element)
+}
+
+// MoveAfter moves the given element after the given previous position.
+func (a *Array) MoveAfter(prevCreatedAt, createdAt, executedAt *time.Ticket) error {
+	return a.elements.MoveAfter(prevCreatedAt, createdAt, executedAt)
+}
+
+// AddNode adds a node placed at the given time at the last. If the element
+// is nil, the node is a position the element has been moved away from.
+func (a *Array) AddNode(positionedAt *time.Ticket, v datatype.Element) *Array {
+	a.elements.AddNode(positionedAt, v)
+	return a
+}
+
+// PositionOf returns the position of the given element.
+func (a *Array) PositionOf(createdAt *time.Ticket) *time.Ticket {
+	return a.elements.PositionOf(createdAt)
+}
+
+// FindPrevCreatedAt returns the position of the element right before the
+// given element including the removed ones.
+func (a *Array) FindPrevCreatedAt(createdAt *time.Ticket) *time.Ticket {
+	return a.elements.FindPrevCreatedAt(createdAt)
 }
 
 // RemoveByCreatedAt removes the given element.
@@ -86,3 +124,73 @@ func (a *Array) RemoveByCreatedAt(createdAt *time.Ticket) datatype.Element {
 func (a *Array) Len() int {
 	return a.elements.Len()
 }
+
+// GetBool returns the value of the given index as bool. ok is false if the
+// index does not exist or its value can not be read as bool.
+func (a *Array) GetBool(idx int) (bool, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return false, false
+	}
+	return p.AsBool()
+}
+
+// GetInteger returns the value of the given index as int. ok is false if the
+// index does not exist or its value can not be read as int.
+func (a *Array) GetInteger(idx int) (int, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return 0, false
+	}
+	return p.AsInteger()
+}
+
+// GetLong returns the value of the given index as int64. ok is false if the
+// index does not exist or its value can not be read as int64.
+func (a *Array) GetLong(idx int) (int64, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return 0, false
+	}
+	return p.AsLong()
+}
+
+// GetDouble returns the value of the given index as float64. ok is false if the
+// index does not exist or its value can not be read as float64.
+func (a *Array) GetDouble(idx int) (float64, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return 0, false
+	}
+	return p.AsDouble()
+}
+
+// GetString returns the value of the given index as string. ok is false if the
+// index does not exist or its value can not be read as string.
+func (a *Array) GetString(idx int) (string, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return "", false
+	}
+	return p.AsString()
+}
+
+// GetBytes returns the value of the given index as []byte. ok is false if the
+// index does not exist or its value can not be read as []byte.
+func (a *Array) GetBytes(idx int) ([]byte, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return nil, false
+	}
+	return p.AsBytes()
+}
+
+// GetDate returns the value of the given index as time.Time. ok is false if the
+// index does not exist or its value can not be read as time.Time.
+func (a *Array) GetDate(idx int) (time2.Time, bool) {
+	p, ok := a.Get(idx).(*datatype.Primitive)
+	if !ok {
+		return time2.Time{}, false
+	}
+	return p.AsDate()
+}
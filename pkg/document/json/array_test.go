@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/document/json"
 	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
 	"github.com/hackerwins/yorkie/pkg/document/time"
@@ -21,4 +22,121 @@ func TestArray(t *testing.T) {
 		a.Add(datatype.NewPrimitive("3", time.InitialTicket))
 		assert.Equal(t, `["1","2","3"]`, a.Marshal())
 	})
+
+	t.Run("insert after test", func(t *testing.T) {
+		ticket := func(lamport uint64) *time.Ticket {
+			return time.NewTicket(lamport, 0, time.InitialActorID)
+		}
+
+		// elements inserted after the same element are ordered from the newest
+		// regardless of the order they arrive in.
+		a1 := json.NewArray(datatype.NewRGA(), time.InitialTicket)
+		a1.Add(datatype.NewPrimitive("1", ticket(1)))
+		a1.InsertAfter(ticket(1), datatype.NewPrimitive("2", ticket(2)))
+		a1.InsertAfter(ticket(1), datatype.NewPrimitive("3", ticket(3)))
+
+		a2 := json.NewArray(datatype.NewRGA(), time.InitialTicket)
+		a2.Add(datatype.NewPrimitive("1", ticket(1)))
+		a2.InsertAfter(ticket(1), datatype.NewPrimitive("3", ticket(3)))
+		a2.InsertAfter(ticket(1), datatype.NewPrimitive("2", ticket(2)))
+
+		assert.Equal(t, `["1","3","2"]`, a1.Marshal())
+		assert.Equal(t, a1.Marshal(), a2.Marshal())
+
+		// an element inserted later goes right after its previous element.
+		a1.InsertAfter(ticket(1), datatype.NewPrimitive("4", ticket(4)))
+		assert.Equal(t, `["1","4","3","2"]`, a1.Marshal())
+	})
+
+	t.Run("index test", func(t *testing.T) {
+		root := json.NewRoot()
+		ctx := change.NewContext(change.InitialID, "")
+		a := json.NewArray(datatype.NewRGA(), ctx.IssueTimeTicket())
+		root.RegisterElement(a)
+
+		for i := 0; i < 10; i++ {
+			a.Add(datatype.NewPrimitive(i, ctx.IssueTimeTicket()))
+		}
+		assert.Equal(t, 10, a.Len())
+		assert.Equal(t, "3", a.Get(3).Marshal())
+		assert.Nil(t, a.Get(10))
+
+		a.Remove(3)
+		a.Remove(0)
+		assert.Equal(t, `[1,2,4,5,6,7,8,9]`, a.Marshal())
+		assert.Equal(t, "4", a.Get(2).Marshal())
+		assert.Equal(t, "9", a.Get(7).Marshal())
+		assert.Equal(t, 8, a.Len())
+
+		a.InsertAfter(a.Get(1).CreatedAt(), datatype.NewPrimitive(3, ctx.IssueTimeTicket()))
+		assert.Equal(t, "3", a.Get(2).Marshal())
+		assert.Equal(t, 2, a.IndexOf(a.Get(2).CreatedAt()))
+
+		a.MoveAfter(time.InitialTicket, a.Get(8).CreatedAt(), ctx.IssueTimeTicket())
+		assert.Equal(t, `[9,1,2,3,4,5,6,7,8]`, a.Marshal())
+		for i, elem := range a.Elements() {
+			assert.Equal(t, elem, a.Get(i))
+			assert.Equal(t, i, a.IndexOf(elem.CreatedAt()))
+		}
+	})
+
+	t.Run("unknown position test", func(t *testing.T) {
+		ctx := change.NewContext(change.InitialID, "")
+		a := json.NewArray(datatype.NewRGA(), ctx.IssueTimeTicket())
+		a.Add(datatype.NewPrimitive(0, ctx.IssueTimeTicket()))
+		unknown := time.NewTicket(100, 0, time.InitialActorID)
+
+		err := a.InsertAfter(unknown, datatype.NewPrimitive(1, ctx.IssueTimeTicket()))
+		assert.Equal(t, datatype.ErrNodeNotFound, err)
+
+		err = a.MoveAfter(unknown, a.Get(0).CreatedAt(), ctx.IssueTimeTicket())
+		assert.Equal(t, datatype.ErrNodeNotFound, err)
+		err = a.MoveAfter(time.InitialTicket, unknown, ctx.IssueTimeTicket())
+		assert.Equal(t, datatype.ErrNodeNotFound, err)
+
+		assert.Equal(t, `[0]`, a.Marshal())
+	})
+}
+
+func BenchmarkArray(b *testing.B) {
+	const size = 50000
+
+	newArray := func() (*json.Array, *change.Context) {
+		ctx := change.NewContext(change.InitialID, "")
+		a := json.NewArray(datatype.NewRGA(), ctx.IssueTimeTicket())
+		for i := 0; i < size; i++ {
+			a.Add(datatype.NewPrimitive(i, ctx.IssueTimeTicket()))
+		}
+		return a, ctx
+	}
+
+	b.Run("get", func(b *testing.B) {
+		a, _ := newArray()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			a.Get(i * 7919 % size)
+		}
+	})
+
+	b.Run("insert", func(b *testing.B) {
+		a, ctx := newArray()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			prev := a.Get(i * 7919 % a.Len())
+			a.InsertAfter(prev.CreatedAt(), datatype.NewPrimitive(i, ctx.IssueTimeTicket()))
+		}
+	})
+
+	b.Run("remove", func(b *testing.B) {
+		a, _ := newArray()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if a.Len() == 0 {
+				b.StopTimer()
+				a, _ = newArray()
+				b.StartTimer()
+			}
+			a.Remove(i * 7919 % a.Len())
+		}
+	})
 }
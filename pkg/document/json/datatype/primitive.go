@@ -25,6 +25,8 @@ const (
 // ValueFromBytes parses the given bytes into value.
 func ValueFromBytes(valueType ValueType, value []byte) interface{} {
 	switch valueType {
+	case Null:
+		return nil
 	case Boolean:
 		if value[0] == 1 {
 			return true
@@ -59,6 +61,12 @@ type Primitive struct {
 // NewPrimitive creates a new instance of Primitive.
 func NewPrimitive(value interface{}, createdAt *time.Ticket) *Primitive {
 	switch val := value.(type) {
+	case nil:
+		return &Primitive{
+			valueType: Null,
+			value:     nil,
+			createdAt: createdAt,
+		}
 	case bool:
 		return &Primitive{
 			valueType: Boolean,
@@ -109,6 +117,8 @@ func NewPrimitive(value interface{}, createdAt *time.Ticket) *Primitive {
 // Bytes creates an array representing the value.
 func (p *Primitive) Bytes() []byte {
 	switch val := p.value.(type) {
+	case nil:
+		return []byte{}
 	case bool:
 		if val {
 			return []byte{1}
@@ -142,6 +152,8 @@ func (p *Primitive) Bytes() []byte {
 // Marshal returns the JSON encoding of the value.
 func (p *Primitive) Marshal() string {
 	switch p.valueType {
+	case Null:
+		return "null"
 	case Boolean:
 		return fmt.Sprintf("%t", p.value)
 	case Integer:
@@ -178,3 +190,66 @@ func (p *Primitive) CreatedAt() *time.Ticket {
 func (p *Primitive) ValueType() ValueType {
 	return p.valueType
 }
+
+// Value returns the value of this primitive.
+func (p *Primitive) Value() interface{} {
+	return p.value
+}
+
+// AsBool returns the value as bool. ok is false if the value is not Boolean.
+func (p *Primitive) AsBool() (v bool, ok bool) {
+	v, ok = p.value.(bool)
+	return v, ok
+}
+
+// AsInteger returns the value as int. ok is false if the value is not
+// Integer.
+func (p *Primitive) AsInteger() (v int, ok bool) {
+	v, ok = p.value.(int)
+	return v, ok
+}
+
+// AsLong returns the value as int64. Integer is also converted into int64.
+func (p *Primitive) AsLong() (int64, bool) {
+	switch val := p.value.(type) {
+	case int:
+		return int64(val), true
+	case int64:
+		return val, true
+	}
+	return 0, false
+}
+
+// AsDouble returns the value as float64. Integer and Long are also converted
+// into float64.
+func (p *Primitive) AsDouble() (float64, bool) {
+	switch val := p.value.(type) {
+	case int:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case float64:
+		return val, true
+	}
+	return 0, false
+}
+
+// AsString returns the value as string. ok is false if the value is not
+// String.
+func (p *Primitive) AsString() (v string, ok bool) {
+	v, ok = p.value.(string)
+	return v, ok
+}
+
+// AsBytes returns the value as []byte. ok is false if the value is not Bytes.
+func (p *Primitive) AsBytes() (v []byte, ok bool) {
+	v, ok = p.value.([]byte)
+	return v, ok
+}
+
+// AsDate returns the value as time.Time. ok is false if the value is not
+// Date.
+func (p *Primitive) AsDate() (v time2.Time, ok bool) {
+	v, ok = p.value.(time2.Time)
+	return v, ok
+}
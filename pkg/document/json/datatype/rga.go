@@ -1,61 +1,113 @@
 package datatype
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/hackerwins/yorkie/pkg/document/time"
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/splay"
 )
 
-type rgaNode struct {
-	prev      *rgaNode
-	next      *rgaNode
-	value     Element
-	isRemoved bool
+var (
+	// ErrNodeNotFound is returned when the node an operation refers to is not
+	// in the RGA.
+	ErrNodeNotFound = errors.New("node not found")
+)
+
+// RGANode is a node of RGA. It is a position of an element: the element is
+// placed at its creation and at every move. Removed nodes and the positions
+// the element has been moved away from remain in RGA as tombstones.
+type RGANode struct {
+	prev         *RGANode
+	next         *RGANode
+	value        Element
+	positionedAt *time.Ticket
+	isRemoved    bool
+	indexNode    *splay.Node
 }
 
-func newRGANode(elem Element) *rgaNode {
-	return &rgaNode{
-		prev:      nil,
-		next:      nil,
-		value:     elem,
-		isRemoved: false,
+func newRGANode(elem Element, positionedAt *time.Ticket) *RGANode {
+	node := &RGANode{
+		prev:         nil,
+		next:         nil,
+		value:        elem,
+		positionedAt: positionedAt,
+		isRemoved:    false,
 	}
+	node.indexNode = splay.NewNode(node)
+	return node
+}
+
+// Element returns the element of this node. It returns nil if the element
+// has been moved away from this node.
+func (n *RGANode) Element() Element {
+	return n.value
+}
+
+// IsRemoved returns whether this node is removed or not.
+func (n *RGANode) IsRemoved() bool {
+	return n.isRemoved
 }
 
-func newNodeAfter(prev *rgaNode, element Element) *rgaNode {
-	newNode := newRGANode(element)
-	prevNext := prev.next
+// PositionedAt returns the time this node was placed. It is the creation time
+// of the element or the time of the move that placed the element here.
+func (n *RGANode) PositionedAt() *time.Ticket {
+	return n.positionedAt
+}
 
-	prev.next = newNode
-	newNode.prev = prev
-	newNode.next = prevNext
-	if prevNext != nil {
-		prevNext.prev = newNode
+// Len returns the length of this node which is used as the weight of the
+// index tree. Removed nodes have no length.
+func (n *RGANode) Len() int {
+	if n.isRemoved {
+		return 0
 	}
+	return 1
+}
 
-	return prev.next
+// String returns the string representation of this node.
+func (n *RGANode) String() string {
+	if n.value == nil {
+		return ""
+	}
+	return n.value.Marshal()
 }
 
-// RGA is replicated growable array.
+// RGA is replicated growable array. The nodes are kept in a linked list for
+// the order and in a splay tree for the index of the live nodes.
+//
+// Nodes are identified by the time they were placed, so an operation refers to
+// the position of the previous element rather than the element itself. A move
+// places the element at a new node and leaves a tombstone at the old one.
+// Among the positions of an element, the latest one wins.
 type RGA struct {
-	nodeMapByCreatedAt map[string]*rgaNode
-	first              *rgaNode
-	last               *rgaNode
-	size               int
+	nodeMapByPositionedAt map[string]*RGANode
+	nodeMapByCreatedAt    map[string]*RGANode
+	treeByIndex           *splay.Tree
+	first                 *RGANode
+	last                  *RGANode
+	size                  int
 }
 
 // NewRGA creates a new instance of RGA.
 func NewRGA() *RGA {
-	nodeMapByCreatedAt := make(map[string]*rgaNode)
-	dummyHead := newRGANode(NewPrimitive("", time.InitialTicket))
-	nodeMapByCreatedAt[dummyHead.value.CreatedAt().Key()] = dummyHead
+	dummyHead := newRGANode(NewPrimitive("", time.InitialTicket), time.InitialTicket)
+	nodeMapByPositionedAt := make(map[string]*RGANode)
+	nodeMapByPositionedAt[dummyHead.positionedAt.Key()] = dummyHead
+
+	// the dummy head is not counted in the index.
+	dummyHead.isRemoved = true
+	treeByIndex := splay.NewTree()
+	treeByIndex.Insert(dummyHead.indexNode)
+	treeByIndex.UpdateSubtree(dummyHead.indexNode)
 
 	return &RGA{
-		nodeMapByCreatedAt: nodeMapByCreatedAt,
-		first:              dummyHead,
-		last:               dummyHead,
-		size:               0,
+		nodeMapByPositionedAt: nodeMapByPositionedAt,
+		nodeMapByCreatedAt:    make(map[string]*RGANode),
+		treeByIndex:           treeByIndex,
+		first:                 dummyHead,
+		last:                  dummyHead,
+		size:                  0,
 	}
 }
 
@@ -89,7 +141,18 @@ func (a *RGA) Marshal() string {
 
 // Add adds the given element at the last.
 func (a *RGA) Add(e Element) {
-	a.insertAfter(a.last, e)
+	a.insertAfter(a.last, newRGANode(e, e.CreatedAt()))
+}
+
+// AddNode adds a node placed at the given time at the last. If the element
+// is nil, the node is a position the element has been moved away from. It is
+// used to restore the RGA from a snapshot.
+func (a *RGA) AddNode(positionedAt *time.Ticket, e Element) {
+	node := newRGANode(e, positionedAt)
+	if e == nil {
+		node.isRemoved = true
+	}
+	a.insertAfter(a.last, node)
 }
 
 // Elements returns an array of elements contained in this RGA.
@@ -112,33 +175,117 @@ func (a *RGA) Elements() []Element {
 	return elements
 }
 
-// LastCreatedAt returns the creation time of last elements.
+// AllNodes returns all the nodes of this RGA including the removed ones.
+func (a *RGA) AllNodes() []*RGANode {
+	var nodes []*RGANode
+	for current := a.first.next; current != nil; current = current.next {
+		nodes = append(nodes, current)
+	}
+
+	return nodes
+}
+
+// LastCreatedAt returns the position of the last node.
 func (a *RGA) LastCreatedAt() *time.Ticket {
-	return a.last.value.CreatedAt()
+	return a.last.positionedAt
+}
+
+// InsertAfter inserts the given element after the node of the given
+// position. It returns ErrNodeNotFound if there is no node of the position.
+func (a *RGA) InsertAfter(prevCreatedAt *time.Ticket, element Element) error {
+	if _, ok := a.nodeMapByPositionedAt[prevCreatedAt.Key()]; !ok {
+		log.Logger.Warn("fail to find ", prevCreatedAt.Key())
+		return ErrNodeNotFound
+	}
+
+	prevNode := a.findByPositionedAt(prevCreatedAt, element.CreatedAt())
+	a.insertAfter(prevNode, newRGANode(element, element.CreatedAt()))
+	return nil
+}
+
+// MoveAfter moves the element of the given createdAt after the node of the
+// given position. The element is placed at a new node and its current node
+// becomes a tombstone. If the element has been moved by a later move, the new
+// node is left as a tombstone instead so that every replica keeps the same
+// nodes and the latest move wins. It returns ErrNodeNotFound if there is no
+// element or node of the position.
+func (a *RGA) MoveAfter(prevCreatedAt, createdAt, executedAt *time.Ticket) error {
+	current, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		log.Logger.Warn("fail to find ", createdAt.Key())
+		return ErrNodeNotFound
+	}
+	if _, ok := a.nodeMapByPositionedAt[prevCreatedAt.Key()]; !ok {
+		log.Logger.Warn("fail to find ", prevCreatedAt.Key())
+		return ErrNodeNotFound
+	}
+
+	prevNode := a.findByPositionedAt(prevCreatedAt, executedAt)
+	if !executedAt.After(current.positionedAt) {
+		node := newRGANode(nil, executedAt)
+		node.isRemoved = true
+		a.insertAfter(prevNode, node)
+		return nil
+	}
+
+	node := newRGANode(current.value, executedAt)
+	node.isRemoved = current.isRemoved
+	a.insertAfter(prevNode, node)
+
+	current.value = nil
+	a.removeNode(current)
+	return nil
 }
 
-// InsertAfter inserts the given element after the given previous element.
-func (a *RGA) InsertAfter(prevCreatedAt *time.Ticket, element Element) {
-	prevNode := a.findByCreatedAt(prevCreatedAt, element.CreatedAt())
-	a.insertAfter(prevNode, element)
+// PositionOf returns the position of the given element. Operations refer to
+// it to place other elements after the element.
+func (a *RGA) PositionOf(createdAt *time.Ticket) *time.Ticket {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		return createdAt
+	}
+
+	return node.positionedAt
+}
+
+// FindPrevCreatedAt returns the position of the node right before the given
+// element including the removed ones. It returns the initial ticket if the
+// element is the first one.
+func (a *RGA) FindPrevCreatedAt(createdAt *time.Ticket) *time.Ticket {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok || node.prev == nil {
+		return time.InitialTicket
+	}
+
+	return node.prev.positionedAt
 }
 
 // Get returns the element of the given index.
 func (a *RGA) Get(idx int) Element {
-	// TODO introduce LLRBTree for improving upstream performance
-	elements := a.Elements()
-	if len(elements) <= idx {
+	if idx < 0 || a.size <= idx {
 		return nil
 	}
 
-	return elements[idx]
+	indexNode, _ := a.treeByIndex.Find(idx + 1)
+	a.treeByIndex.Splay(indexNode)
+	return indexNode.Value().(*RGANode).value
+}
+
+// IndexOf returns the index of the given element. It returns -1 if the
+// element is not in this RGA or removed.
+func (a *RGA) IndexOf(createdAt *time.Ticket) int {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok || node.isRemoved {
+		return -1
+	}
+
+	return a.treeByIndex.IndexOf(node.indexNode)
 }
 
 // RemoveByCreatedAt removes the given element.
 func (a *RGA) RemoveByCreatedAt(createdAt *time.Ticket) Element {
 	if node, ok := a.nodeMapByCreatedAt[createdAt.Key()]; ok {
-		node.isRemoved = true
-		a.size--
+		a.removeNode(node)
 		return node.value
 	}
 
@@ -151,21 +298,47 @@ func (a *RGA) Len() int {
 	return a.size
 }
 
-func (a *RGA) findByCreatedAt(prevCreatedAt *time.Ticket, createdAt *time.Ticket) *rgaNode {
-	node := a.nodeMapByCreatedAt[prevCreatedAt.Key()]
-	for node.next != nil && createdAt.After(node.next.value.CreatedAt()) {
+// findByPositionedAt returns the node to place a node at the given time
+// after. Among the nodes placed after the same node, the newer one comes
+// first, so the nodes placed later than the given time are skipped.
+func (a *RGA) findByPositionedAt(prevCreatedAt *time.Ticket, positionedAt *time.Ticket) *RGANode {
+	node := a.nodeMapByPositionedAt[prevCreatedAt.Key()]
+	for node.next != nil && node.next.positionedAt.After(positionedAt) {
 		node = node.next
 	}
 
 	return node
 }
 
-func (a *RGA) insertAfter(prev *rgaNode, element Element) {
-	newNode := newNodeAfter(prev, element)
+func (a *RGA) insertAfter(prev *RGANode, node *RGANode) {
+	node.prev = prev
+	node.next = prev.next
+	if prev.next != nil {
+		prev.next.prev = node
+	}
+	prev.next = node
+
 	if prev == a.last {
-		a.last = newNode
+		a.last = node
+	}
+	a.treeByIndex.InsertAfter(prev.indexNode, node.indexNode)
+
+	if !node.isRemoved {
+		a.size++
+	}
+	a.nodeMapByPositionedAt[node.positionedAt.Key()] = node
+	if node.value != nil {
+		a.nodeMapByCreatedAt[node.value.CreatedAt().Key()] = node
+	}
+}
+
+func (a *RGA) removeNode(node *RGANode) {
+	if node.isRemoved {
+		return
 	}
 
-	a.size++
-	a.nodeMapByCreatedAt[element.CreatedAt().Key()] = newNode
+	node.isRemoved = true
+	a.treeByIndex.Splay(node.indexNode)
+	a.treeByIndex.UpdateSubtree(node.indexNode)
+	a.size--
 }
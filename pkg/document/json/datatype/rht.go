@@ -9,6 +9,37 @@ import (
 	"github.com/hackerwins/yorkie/pkg/log"
 )
 
+// RHTNode is a node of RHT. It is used to encode RHT with its tombstones.
+type RHTNode struct {
+	key       string
+	elem      Element
+	isRemoved bool
+}
+
+// NewRHTNode creates a new instance of RHTNode.
+func NewRHTNode(key string, elem Element, isRemoved bool) *RHTNode {
+	return &RHTNode{
+		key:       key,
+		elem:      elem,
+		isRemoved: isRemoved,
+	}
+}
+
+// Key returns the key of this node.
+func (n *RHTNode) Key() string {
+	return n.key
+}
+
+// Element returns the element of this node.
+func (n *RHTNode) Element() Element {
+	return n.elem
+}
+
+// IsRemoved returns whether the element of this node is removed or not.
+func (n *RHTNode) IsRemoved() bool {
+	return n.isRemoved
+}
+
 // RHT is replicated hash table.
 type RHT struct {
 	elementQueueMapByKey map[string]*PriorityQueue
@@ -67,6 +98,18 @@ func (rht *RHT) RemoveByCreatedAt(createdAt *time.Ticket) Element {
 	return nil
 }
 
+// Len returns the number of the members which are not removed.
+func (rht *RHT) Len() int {
+	size := 0
+	for _, queue := range rht.elementQueueMapByKey {
+		if item := queue.Peek(); !item.isRemoved {
+			size++
+		}
+	}
+
+	return size
+}
+
 // Members returns a map of elements because the map easy to use for loop.
 // TODO If we encounter performance issues, we need to replace this with other solution.
 func (rht *RHT) Members() map[string]Element {
@@ -80,6 +123,27 @@ func (rht *RHT) Members() map[string]Element {
 	return elementMap
 }
 
+// AllNodes returns all the nodes of this RHT including the removed elements
+// and the elements overwritten by newer ones, ordered by key and creation
+// time.
+func (rht *RHT) AllNodes() []*RHTNode {
+	var nodes []*RHTNode
+	for key, queue := range rht.elementQueueMapByKey {
+		for _, item := range *queue.queue {
+			nodes = append(nodes, NewRHTNode(key, item.value, item.isRemoved))
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].key != nodes[j].key {
+			return nodes[i].key < nodes[j].key
+		}
+		return nodes[j].elem.CreatedAt().After(nodes[i].elem.CreatedAt())
+	})
+
+	return nodes
+}
+
 // Marshal returns the JSON encoding of this RHT.
 func (rht *RHT) Marshal() string {
 	members := rht.Members()
@@ -111,6 +111,15 @@ func newTextNode(id *TextNodeID, value string) *TextNode {
 	return node
 }
 
+// NewTextNode creates a new instance of TextNode. If deletedAt is not nil,
+// the node is a tombstone.
+func NewTextNode(id *TextNodeID, value string, deletedAt *time.Ticket) *TextNode {
+	node := newTextNode(id, value)
+	node.deletedAt = deletedAt
+
+	return node
+}
+
 func (t *TextNode) ID() *TextNodeID {
 	return t.id
 }
@@ -123,6 +132,12 @@ func (t *TextNode) InsPrevID() *TextNodeID {
 	return t.insPrev.id
 }
 
+// DeletedAt returns the deletion time of this node. It is nil if this node is
+// not deleted.
+func (t *TextNode) DeletedAt() *time.Ticket {
+	return t.deletedAt
+}
+
 func (t *TextNode) contentLen() int {
 	return len(t.value)
 }
@@ -484,6 +499,11 @@ func (t *Text) Marshal() string {
 	return fmt.Sprintf("\"%s\"", t.rgaTreeSplit.marshal())
 }
 
+// String returns the content of this Text.
+func (t *Text) String() string {
+	return t.rgaTreeSplit.marshal()
+}
+
 func (t *Text) Deepcopy() Element {
 	rgaTreeSplit := NewRGATreeSplit()
 
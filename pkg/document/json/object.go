@@ -1,6 +1,8 @@
 package json
 
 import (
+	time2 "time"
+
 	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
 	"github.com/hackerwins/yorkie/pkg/document/time"
 )
@@ -30,6 +32,16 @@ func (o *Object) Members() map[string]datatype.Element {
 	return o.members.Members()
 }
 
+// Len returns the number of the members of this object.
+func (o *Object) Len() int {
+	return o.members.Len()
+}
+
+// AllNodes returns all the nodes of this object including the removed ones.
+func (o *Object) AllNodes() []*datatype.RHTNode {
+	return o.members.AllNodes()
+}
+
 // Marshal returns the JSON encoding of this object.
 func (o *Object) Marshal() string {
 	return o.members.Marshal()
@@ -65,3 +77,73 @@ func (o *Object) RemoveByCreatedAt(createdAt *time.Ticket) datatype.Element {
 func (o *Object) Remove(k string) datatype.Element {
 	return o.members.Remove(k)
 }
+
+// GetBool returns the value of the given key as bool. ok is false if the
+// key does not exist or its value can not be read as bool.
+func (o *Object) GetBool(k string) (bool, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return false, false
+	}
+	return p.AsBool()
+}
+
+// GetInteger returns the value of the given key as int. ok is false if the
+// key does not exist or its value can not be read as int.
+func (o *Object) GetInteger(k string) (int, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return 0, false
+	}
+	return p.AsInteger()
+}
+
+// GetLong returns the value of the given key as int64. ok is false if the
+// key does not exist or its value can not be read as int64.
+func (o *Object) GetLong(k string) (int64, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return 0, false
+	}
+	return p.AsLong()
+}
+
+// GetDouble returns the value of the given key as float64. ok is false if the
+// key does not exist or its value can not be read as float64.
+func (o *Object) GetDouble(k string) (float64, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return 0, false
+	}
+	return p.AsDouble()
+}
+
+// GetString returns the value of the given key as string. ok is false if the
+// key does not exist or its value can not be read as string.
+func (o *Object) GetString(k string) (string, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return "", false
+	}
+	return p.AsString()
+}
+
+// GetBytes returns the value of the given key as []byte. ok is false if the
+// key does not exist or its value can not be read as []byte.
+func (o *Object) GetBytes(k string) ([]byte, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return nil, false
+	}
+	return p.AsBytes()
+}
+
+// GetDate returns the value of the given key as time.Time. ok is false if the
+// key does not exist or its value can not be read as time.Time.
+func (o *Object) GetDate(k string) (time2.Time, bool) {
+	p, ok := o.Get(k).(*datatype.Primitive)
+	if !ok {
+		return time2.Time{}, false
+	}
+	return p.AsDate()
+}
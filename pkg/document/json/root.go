@@ -28,6 +28,18 @@ func NewRoot() *Root {
 	}
 }
 
+// NewRootFromObject creates a new instance of Root with the given object. All
+// the descendants of the object including the removed ones are registered.
+func NewRootFromObject(object *Object) *Root {
+	root := &Root{
+		object:                object,
+		elementMapByCreatedAt: make(map[string]datatype.Element),
+	}
+	root.registerDescendants(object)
+
+	return root
+}
+
 // Object returns the root object of the JSON.
 func (r *Root) Object() *Object {
 	return r.object
@@ -42,3 +54,20 @@ func (r *Root) FindByCreatedAt(ticket *time.Ticket) datatype.Element {
 func (r *Root) RegisterElement(elem datatype.Element) {
 	r.elementMapByCreatedAt[elem.CreatedAt().Key()] = elem
 }
+
+func (r *Root) registerDescendants(elem datatype.Element) {
+	r.RegisterElement(elem)
+
+	switch elem := elem.(type) {
+	case *Object:
+		for _, node := range elem.AllNodes() {
+			r.registerDescendants(node.Element())
+		}
+	case *Array:
+		for _, node := range elem.AllNodes() {
+			if node.Element() != nil {
+				r.registerDescendants(node.Element())
+			}
+		}
+	}
+}
@@ -40,7 +40,9 @@ func (o *Add) Execute(root *json.Root) error {
 		return err
 	}
 
-	obj.InsertAfter(o.prevCreatedAt, o.value)
+	if err := obj.InsertAfter(o.prevCreatedAt, o.value); err != nil {
+		return err
+	}
 	root.RegisterElement(o.value)
 	return nil
 }
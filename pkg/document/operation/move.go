@@ -0,0 +1,65 @@
+package operation
+
+import (
+	"fmt"
+
+	"github.com/hackerwins/yorkie/pkg/document/json"
+	"github.com/hackerwins/yorkie/pkg/document/time"
+	"github.com/hackerwins/yorkie/pkg/log"
+)
+
+// Move is an operation that moves an element of Array after the position of
+// the previous element.
+type Move struct {
+	parentCreatedAt *time.Ticket
+	prevCreatedAt   *time.Ticket
+	createdAt       *time.Ticket
+	executedAt      *time.Ticket
+}
+
+func NewMove(
+	parentCreatedAt *time.Ticket,
+	prevCreatedAt *time.Ticket,
+	createdAt *time.Ticket,
+	executedAt *time.Ticket,
+) *Move {
+	return &Move{
+		parentCreatedAt: parentCreatedAt,
+		prevCreatedAt:   prevCreatedAt,
+		createdAt:       createdAt,
+		executedAt:      executedAt,
+	}
+}
+
+func (o *Move) Execute(root *json.Root) error {
+	parent := root.FindByCreatedAt(o.parentCreatedAt)
+
+	obj, ok := parent.(*json.Array)
+	if !ok {
+		err := fmt.Errorf("fail to execute, only Array can execute Move")
+		log.Logger.Error(err)
+		return err
+	}
+
+	return obj.MoveAfter(o.prevCreatedAt, o.createdAt, o.executedAt)
+}
+
+func (o *Move) ParentCreatedAt() *time.Ticket {
+	return o.parentCreatedAt
+}
+
+func (o *Move) ExecutedAt() *time.Ticket {
+	return o.executedAt
+}
+
+func (o *Move) SetActor(actorID *time.ActorID) {
+	o.executedAt = o.executedAt.SetActorID(actorID)
+}
+
+func (o *Move) PrevCreatedAt() *time.Ticket {
+	return o.prevCreatedAt
+}
+
+func (o *Move) CreatedAt() *time.Ticket {
+	return o.createdAt
+}
@@ -0,0 +1,178 @@
+package document
+
+import (
+	"bytes"
+	gojson "encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/hackerwins/yorkie/pkg/document/json"
+	"github.com/hackerwins/yorkie/pkg/document/proxy"
+)
+
+var (
+	// ErrInvalidPatch is returned when the given patch is malformed.
+	ErrInvalidPatch = errors.New("invalid patch")
+
+	// ErrPatchTestFailed is returned when a test operation of the given JSON
+	// Patch fails.
+	ErrPatchTestFailed = errors.New("patch test failed")
+)
+
+// patchOperation is an operation of RFC 6902 JSON Patch.
+type patchOperation struct {
+	Op    string             `json:"op"`
+	Path  *string            `json:"path"`
+	From  *string            `json:"from"`
+	Value *gojson.RawMessage `json:"value"`
+}
+
+// ApplyPatch applies the given RFC 6902 JSON Patch to the given document in
+// one Update. If any operation fails including test, none of the operations
+// is applied.
+func ApplyPatch(doc *Document, patch []byte) error {
+	var ops []patchOperation
+	if err := gojson.Unmarshal(patch, &ops); err != nil {
+		return ErrInvalidPatch
+	}
+
+	return doc.Update(func(root *proxy.ObjectProxy) error {
+		for _, op := range ops {
+			if err := applyPatchOperation(root, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func applyPatchOperation(root *proxy.ObjectProxy, op patchOperation) error {
+	if op.Path == nil {
+		return ErrInvalidPatch
+	}
+	path := *op.Path
+
+	switch op.Op {
+	case "add":
+		if op.Value == nil {
+			return ErrInvalidPatch
+		}
+		return root.AddPath(path, *op.Value)
+	case "remove":
+		return root.RemovePath(path)
+	case "replace":
+		if op.Value == nil {
+			return ErrInvalidPatch
+		}
+		if _, err := root.GetPath(path); err != nil {
+			return err
+		}
+		return root.SetPath(path, *op.Value)
+	case "move":
+		if op.From == nil {
+			return ErrInvalidPatch
+		}
+		return root.MovePath(*op.From, path)
+	case "copy":
+		if op.From == nil {
+			return ErrInvalidPatch
+		}
+		elem, err := root.GetPath(*op.From)
+		if err != nil {
+			return err
+		}
+		return root.AddPath(path, proxy.ValueOf(elem))
+	case "test":
+		if op.Value == nil {
+			return ErrInvalidPatch
+		}
+		elem, err := root.GetPath(path)
+		if err != nil {
+			return err
+		}
+		equal, err := equalJSON(proxy.ValueOf(elem), *op.Value)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			return ErrPatchTestFailed
+		}
+		return nil
+	}
+
+	return ErrInvalidPatch
+}
+
+// equalJSON returns whether the given value and the JSON encoded value are
+// equal as JSON values.
+func equalJSON(value interface{}, raw gojson.RawMessage) (bool, error) {
+	encoded, err := gojson.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	var actual, expected interface{}
+	if err := gojson.Unmarshal(encoded, &actual); err != nil {
+		return false, err
+	}
+	if err := gojson.Unmarshal(raw, &expected); err != nil {
+		return false, ErrInvalidPatch
+	}
+
+	return reflect.DeepEqual(actual, expected), nil
+}
+
+// ApplyMergePatch applies the given RFC 7386 JSON Merge Patch to the given
+// document in one Update. The patch must be an object because the root of
+// the document is an object.
+func ApplyMergePatch(doc *Document, patch []byte) error {
+	decoder := gojson.NewDecoder(bytes.NewReader(patch))
+	decoder.UseNumber()
+
+	var members map[string]interface{}
+	if err := decoder.Decode(&members); err != nil || members == nil {
+		return ErrInvalidPatch
+	}
+
+	return doc.Update(func(root *proxy.ObjectProxy) error {
+		return mergePatch(root, members)
+	})
+}
+
+func mergePatch(target *proxy.ObjectProxy, patch map[string]interface{}) error {
+	keys := make([]string, 0, len(patch))
+	for k := range patch {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value := patch[k]
+		if value == nil {
+			if target.Object.Get(k) != nil {
+				target.Remove(k)
+			}
+			continue
+		}
+
+		members, ok := value.(map[string]interface{})
+		if !ok {
+			if err := target.SetValue(k, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch target.Object.Get(k).(type) {
+		case *json.Object, *proxy.ObjectProxy:
+		default:
+			target.SetNewObject(k)
+		}
+		if err := mergePatch(target.GetObject(k), members); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
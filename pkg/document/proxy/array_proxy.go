@@ -89,6 +89,14 @@ func (p *ArrayProxy) AddString(v string) *ArrayProxy {
 	return p
 }
 
+func (p *ArrayProxy) AddNewObject() *ObjectProxy {
+	v := p.addInternal(func(ticket *time.Ticket) datatype.Element {
+		return NewObjectProxy(p.context, datatype.NewRHT(), ticket)
+	})
+
+	return v.(*ObjectProxy)
+}
+
 func (p *ArrayProxy) AddNewArray() *ArrayProxy {
 	v := p.addInternal(func(ticket *time.Ticket) datatype.Element {
 		return NewArrayProxy(p.context, datatype.NewRGA(), ticket)
@@ -97,6 +105,146 @@ func (p *ArrayProxy) AddNewArray() *ArrayProxy {
 	return v.(*ArrayProxy)
 }
 
+func (p *ArrayProxy) AddNewText() *TextProxy {
+	v := p.addInternal(func(ticket *time.Ticket) datatype.Element {
+		return NewTextProxy(p.context, datatype.NewRGATreeSplit(), ticket)
+	})
+
+	return v.(*TextProxy)
+}
+
+// AddValue adds the given value after building the equivalent element. See
+// ObjectProxy.SetValue.
+func (p *ArrayProxy) AddValue(v interface{}) error {
+	return p.insertValueAfter(p.Array.LastCreatedAt(), v)
+}
+
+// AddValues adds the given values in order. See AddValue.
+func (p *ArrayProxy) AddValues(values ...interface{}) error {
+	for _, v := range values {
+		if err := p.AddValue(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InsertAt inserts the given value at the given index. The elements at and
+// after the index are shifted. See AddValue for the values it can build.
+func (p *ArrayProxy) InsertAt(idx int, v interface{}) error {
+	if idx < 0 || idx > p.Len() {
+		return ErrIndexOutOfRange
+	}
+
+	prevCreatedAt := time.InitialTicket
+	if idx > 0 {
+		prevCreatedAt = p.Array.PositionOf(p.Get(idx - 1).CreatedAt())
+	}
+
+	return p.insertValueAfter(prevCreatedAt, v)
+}
+
+// InsertAfter inserts the given value right after the given element of this
+// array. If prev is nil, the value is inserted at the front.
+func (p *ArrayProxy) InsertAfter(prev datatype.Element, v interface{}) error {
+	if prev == nil {
+		return p.insertValueAfter(time.InitialTicket, v)
+	}
+
+	if !p.contains(prev) {
+		return ErrElementNotFound
+	}
+
+	return p.insertValueAfter(p.Array.PositionOf(prev.CreatedAt()), v)
+}
+
+// MoveBefore moves the given element of this array right before the given
+// next element.
+func (p *ArrayProxy) MoveBefore(next, elem datatype.Element) error {
+	if !p.contains(next) || !p.contains(elem) {
+		return ErrElementNotFound
+	}
+
+	return p.moveAfterInternal(p.Array.FindPrevCreatedAt(next.CreatedAt()), elem.CreatedAt())
+}
+
+// MoveAfter moves the given element of this array right after the given
+// previous element.
+func (p *ArrayProxy) MoveAfter(prev, elem datatype.Element) error {
+	if !p.contains(prev) || !p.contains(elem) {
+		return ErrElementNotFound
+	}
+
+	return p.moveAfterInternal(p.Array.PositionOf(prev.CreatedAt()), elem.CreatedAt())
+}
+
+// MoveFront moves the given element of this array to the front.
+func (p *ArrayProxy) MoveFront(elem datatype.Element) error {
+	if !p.contains(elem) {
+		return ErrElementNotFound
+	}
+
+	return p.moveAfterInternal(time.InitialTicket, elem.CreatedAt())
+}
+
+// MoveLast moves the given element of this array to the last.
+func (p *ArrayProxy) MoveLast(elem datatype.Element) error {
+	if !p.contains(elem) {
+		return ErrElementNotFound
+	}
+
+	return p.moveAfterInternal(p.Array.LastCreatedAt(), elem.CreatedAt())
+}
+
+func (p *ArrayProxy) GetObject(idx int) *ObjectProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *json.Object:
+		return ProxyObject(p.context, elem)
+	case *ObjectProxy:
+		return ProxyObject(p.context, elem.Object)
+	default:
+		panic("unsupported type")
+	}
+}
+
+func (p *ArrayProxy) GetArray(idx int) *ArrayProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *json.Array:
+		return ProxyArray(p.context, elem)
+	case *ArrayProxy:
+		return ProxyArray(p.context, elem.Array)
+	default:
+		panic("unsupported type")
+	}
+}
+
+func (p *ArrayProxy) GetText(idx int) *TextProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *datatype.Text:
+		return ProxyText(p.context, elem)
+	case *TextProxy:
+		return ProxyText(p.context, elem.Text)
+	default:
+		panic("unsupported type")
+	}
+}
+
 func (p *ArrayProxy) Remove(idx int) datatype.Element {
 	removed := p.Array.Remove(idx)
 
@@ -114,22 +262,92 @@ func (p *ArrayProxy) Remove(idx int) datatype.Element {
 
 func (p *ArrayProxy) addInternal(
 	creator func(ticket *time.Ticket) datatype.Element,
+) datatype.Element {
+	return p.insertAfterInternal(p.Array.LastCreatedAt(), creator)
+}
+
+func (p *ArrayProxy) insertAfterInternal(
+	prevCreatedAt *time.Ticket,
+	creator func(ticket *time.Ticket) datatype.Element,
 ) datatype.Element {
 	ticket := p.context.IssueTimeTicket()
 	value := creator(ticket)
 
 	p.context.Push(operation.NewAdd(
 		p.Array.CreatedAt(),
-		p.Array.LastCreatedAt(),
+		prevCreatedAt,
 		toOriginal(value),
 		ticket,
 	))
 
-	p.Add(value)
+	// the previous position is taken from this array, so it is always found.
+	if err := p.Array.InsertAfter(prevCreatedAt, value); err != nil {
+		panic(err)
+	}
 
 	return value
 }
 
+func (p *ArrayProxy) moveAfterInternal(prevCreatedAt, createdAt *time.Ticket) error {
+	// the element is already at the position.
+	if prevCreatedAt.Compare(p.Array.PositionOf(createdAt)) == 0 {
+		return nil
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	if err := p.Array.MoveAfter(prevCreatedAt, createdAt, ticket); err != nil {
+		return err
+	}
+
+	p.context.Push(operation.NewMove(
+		p.Array.CreatedAt(),
+		prevCreatedAt,
+		createdAt,
+		ticket,
+	))
+	return nil
+}
+
+// contains returns whether the given element is in this array or not.
+func (p *ArrayProxy) contains(elem datatype.Element) bool {
+	if elem == nil {
+		return false
+	}
+
+	return p.Array.IndexOf(elem.CreatedAt()) >= 0
+}
+
+func (p *ArrayProxy) insertValueAfter(prevCreatedAt *time.Ticket, v interface{}) error {
+	v, err := normalizeValue(v)
+	if err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		obj := p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) datatype.Element {
+			return NewObjectProxy(p.context, datatype.NewRHT(), ticket)
+		})
+		return obj.(*ObjectProxy).SetValues(v)
+	case []interface{}:
+		array := p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) datatype.Element {
+			return NewArrayProxy(p.context, datatype.NewRGA(), ticket)
+		})
+		return array.(*ArrayProxy).AddValues(v...)
+	case Text:
+		text := p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) datatype.Element {
+			return NewTextProxy(p.context, datatype.NewRGATreeSplit(), ticket)
+		})
+		text.(*TextProxy).Edit(0, 0, string(v))
+	default:
+		p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) datatype.Element {
+			return datatype.NewPrimitive(v, ticket)
+		})
+	}
+
+	return nil
+}
+
 func (p *ArrayProxy) Len() int {
 	return p.Array.Len()
 }
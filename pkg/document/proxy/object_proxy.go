@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"sort"
 	time2 "time"
 
 	"github.com/hackerwins/yorkie/pkg/document/change"
@@ -114,6 +115,48 @@ func (p *ObjectProxy) SetDate(k string, v time2.Time) *ObjectProxy {
 	return p
 }
 
+// SetValue sets the given value under the given key after building the
+// equivalent element. Maps and slices of encoding/json are built as nested
+// objects and arrays, and Text is built as a Text.
+func (p *ObjectProxy) SetValue(k string, v interface{}) error {
+	v, err := normalizeValue(v)
+	if err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return p.SetNewObject(k).SetValues(v)
+	case []interface{}:
+		return p.SetNewArray(k).AddValues(v...)
+	case Text:
+		p.SetNewText(k).Edit(0, 0, string(v))
+	default:
+		p.setInternal(k, func(ticket *time.Ticket) datatype.Element {
+			return datatype.NewPrimitive(v, ticket)
+		})
+	}
+
+	return nil
+}
+
+// SetValues sets the given values in the order of the keys. See SetValue.
+func (p *ObjectProxy) SetValues(values map[string]interface{}) error {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := p.SetValue(k, values[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (p *ObjectProxy) Remove(k string) datatype.Element {
 	removed := p.Object.Remove(k)
 
@@ -139,7 +182,7 @@ func (p *ObjectProxy) GetObject(k string) *ObjectProxy {
 	case *json.Object:
 		return ProxyObject(p.context, elem)
 	case *ObjectProxy:
-		return elem
+		return ProxyObject(p.context, elem.Object)
 	default:
 		panic("unsupported type")
 	}
@@ -155,7 +198,7 @@ func (p *ObjectProxy) GetArray(k string) *ArrayProxy {
 	case *json.Array:
 		return ProxyArray(p.context, elem)
 	case *ArrayProxy:
-		return elem
+		return ProxyArray(p.context, elem.Array)
 	default:
 		panic("unsupported type")
 	}
@@ -171,7 +214,7 @@ func (p *ObjectProxy) GetText(k string) *TextProxy {
 	case *datatype.Text:
 		return ProxyText(p.context, elem)
 	case *TextProxy:
-		return elem
+		return ProxyText(p.context, elem.Text)
 	default:
 		panic("unsupported type")
 	}
@@ -0,0 +1,350 @@
+package proxy
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hackerwins/yorkie/pkg/document/change"
+	"github.com/hackerwins/yorkie/pkg/document/json"
+	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
+	"github.com/hackerwins/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrInvalidPath is returned when the given path is not a valid JSON
+	// Pointer or can not be applied to the document.
+	ErrInvalidPath = errors.New("invalid path")
+
+	// ErrPathNotFound is returned when there is no element at the given path.
+	ErrPathNotFound = errors.New("path not found")
+)
+
+// SetPath sets the given value at the given path in RFC 6901 JSON Pointer
+// syntax, e.g. "/settings/theme/color". Missing intermediate objects are
+// created. The value at an array index is replaced, and "-" as the last
+// token appends the value to the array. See SetValue for the values it can
+// build.
+func (p *ObjectProxy) SetPath(path string, v interface{}) error {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return ErrInvalidPath
+	}
+
+	parent, err := p.findContainer(tokens[:len(tokens)-1], true)
+	if err != nil {
+		return err
+	}
+
+	last := tokens[len(tokens)-1]
+	switch parent := parent.(type) {
+	case *ObjectProxy:
+		return parent.SetValue(last, v)
+	case *ArrayProxy:
+		if last == "-" {
+			return parent.AddValue(v)
+		}
+		idx, err := parseArrayIndex(last)
+		if err != nil {
+			return err
+		}
+		if idx == parent.Len() {
+			return parent.AddValue(v)
+		}
+		return parent.replaceValueAt(idx, v)
+	}
+
+	return ErrInvalidPath
+}
+
+// AddPath adds the given value at the given path in RFC 6901 JSON Pointer
+// syntax like the add operation of RFC 6902 JSON Patch. Unlike SetPath, the
+// value is inserted at an array index, and missing intermediate objects are
+// not created.
+func (p *ObjectProxy) AddPath(path string, v interface{}) error {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return ErrInvalidPath
+	}
+
+	parent, err := p.findContainer(tokens[:len(tokens)-1], false)
+	if err != nil {
+		return err
+	}
+
+	last := tokens[len(tokens)-1]
+	switch parent := parent.(type) {
+	case *ObjectProxy:
+		return parent.SetValue(last, v)
+	case *ArrayProxy:
+		if last == "-" {
+			return parent.AddValue(v)
+		}
+		idx, err := parseArrayIndex(last)
+		if err != nil {
+			return err
+		}
+		return parent.InsertAt(idx, v)
+	}
+
+	return ErrInvalidPath
+}
+
+// GetPath returns the element at the given path in RFC 6901 JSON Pointer
+// syntax. The empty path means this object.
+func (p *ObjectProxy) GetPath(path string) (datatype.Element, error) {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return p.Object, nil
+	}
+
+	parent, err := p.findContainer(tokens[:len(tokens)-1], false)
+	if err != nil {
+		return nil, err
+	}
+
+	var elem datatype.Element
+	last := tokens[len(tokens)-1]
+	switch parent := parent.(type) {
+	case *ObjectProxy:
+		elem = parent.Object.Get(last)
+	case *ArrayProxy:
+		idx, err := parseArrayIndex(last)
+		if err != nil {
+			return nil, err
+		}
+		elem = parent.Array.Get(idx)
+	}
+	if elem == nil {
+		return nil, ErrPathNotFound
+	}
+
+	return elem, nil
+}
+
+// RemovePath removes the element at the given path in RFC 6901 JSON Pointer
+// syntax.
+func (p *ObjectProxy) RemovePath(path string) error {
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return ErrInvalidPath
+	}
+
+	parent, err := p.findContainer(tokens[:len(tokens)-1], false)
+	if err != nil {
+		return err
+	}
+
+	last := tokens[len(tokens)-1]
+	switch parent := parent.(type) {
+	case *ObjectProxy:
+		if parent.Object.Get(last) == nil {
+			return ErrPathNotFound
+		}
+		parent.Remove(last)
+		return nil
+	case *ArrayProxy:
+		idx, err := parseArrayIndex(last)
+		if err != nil {
+			return err
+		}
+		if parent.Remove(idx) == nil {
+			return ErrPathNotFound
+		}
+		return nil
+	}
+
+	return ErrInvalidPath
+}
+
+// MovePath moves the element at the given from path to the given path in
+// RFC 6901 JSON Pointer syntax like the move operation of RFC 6902 JSON
+// Patch. If both paths are in the same array, the element is moved with
+// ArrayProxy.MoveAfter so that it keeps its identity and concurrent moves of
+// it converge. Otherwise its value is removed and added at the path.
+func (p *ObjectProxy) MovePath(from string, path string) error {
+	fromTokens, err := parsePointer(from)
+	if err != nil {
+		return err
+	}
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return err
+	}
+
+	if len(fromTokens) > 0 && len(tokens) == len(fromTokens) &&
+		reflect.DeepEqual(fromTokens[:len(fromTokens)-1], tokens[:len(tokens)-1]) {
+		parent, err := p.findContainer(tokens[:len(tokens)-1], false)
+		if err != nil {
+			return err
+		}
+		if arr, ok := parent.(*ArrayProxy); ok {
+			return arr.moveIndex(fromTokens[len(fromTokens)-1], tokens[len(tokens)-1])
+		}
+	}
+
+	elem, err := p.GetPath(from)
+	if err != nil {
+		return err
+	}
+	value := ValueOf(elem)
+	if err := p.RemovePath(from); err != nil {
+		return err
+	}
+
+	return p.AddPath(path, value)
+}
+
+// findContainer returns the proxy of the object or array at the given
+// tokens. If createMissing is true, missing objects are created on the way.
+func (p *ObjectProxy) findContainer(tokens []string, createMissing bool) (interface{}, error) {
+	var current interface{} = p
+	for _, token := range tokens {
+		var elem datatype.Element
+		switch container := current.(type) {
+		case *ObjectProxy:
+			elem = container.Object.Get(token)
+			if elem == nil {
+				if !createMissing {
+					return nil, ErrPathNotFound
+				}
+				current = container.SetNewObject(token)
+				continue
+			}
+			current = toProxy(container.context, elem)
+		case *ArrayProxy:
+			idx, err := parseArrayIndex(token)
+			if err != nil {
+				return nil, err
+			}
+			elem = container.Array.Get(idx)
+			if elem == nil {
+				return nil, ErrPathNotFound
+			}
+			current = toProxy(container.context, elem)
+		}
+
+		if current == nil {
+			return nil, ErrInvalidPath
+		}
+	}
+
+	return current, nil
+}
+
+// replaceValueAt replaces the element at the given index with the given
+// value.
+func (p *ArrayProxy) replaceValueAt(idx int, v interface{}) error {
+	if idx < 0 || idx >= p.Len() {
+		return ErrIndexOutOfRange
+	}
+
+	prevCreatedAt := time.InitialTicket
+	if idx > 0 {
+		prevCreatedAt = p.Array.PositionOf(p.Get(idx - 1).CreatedAt())
+	}
+	p.Remove(idx)
+
+	return p.insertValueAfter(prevCreatedAt, v)
+}
+
+// moveIndex moves the element at the given from token to the given token.
+// Like the move operation of JSON Patch, the token is the index of the
+// element in the array after the element is taken out, or "-" for the last.
+func (p *ArrayProxy) moveIndex(fromToken string, token string) error {
+	from, err := parseArrayIndex(fromToken)
+	if err != nil {
+		return err
+	}
+	elem := p.Get(from)
+	if elem == nil {
+		return ErrPathNotFound
+	}
+
+	if token == "-" {
+		return p.MoveLast(elem)
+	}
+	to, err := parseArrayIndex(token)
+	if err != nil {
+		return err
+	}
+	if to >= p.Len() {
+		return ErrIndexOutOfRange
+	}
+
+	switch {
+	case to == from:
+		return nil
+	case to == 0:
+		return p.MoveFront(elem)
+	case to < from:
+		return p.MoveAfter(p.Get(to-1), elem)
+	default:
+		return p.MoveAfter(p.Get(to), elem)
+	}
+}
+
+// toProxy returns the proxy of the given object or array. It returns nil for
+// the other elements.
+func toProxy(ctx *change.Context, elem datatype.Element) interface{} {
+	switch elem := elem.(type) {
+	case *json.Object:
+		return ProxyObject(ctx, elem)
+	case *ObjectProxy:
+		return ProxyObject(ctx, elem.Object)
+	case *json.Array:
+		return ProxyArray(ctx, elem)
+	case *ArrayProxy:
+		return ProxyArray(ctx, elem.Array)
+	}
+
+	return nil
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// parsePointer parses the given JSON Pointer into the unescaped reference
+// tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, ErrInvalidPath
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+
+	return tokens, nil
+}
+
+// parseArrayIndex parses the given token into an array index. Leading zeros
+// are not allowed.
+func parseArrayIndex(token string) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, ErrInvalidPath
+	}
+
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 {
+		return 0, ErrInvalidPath
+	}
+
+	return idx, nil
+}
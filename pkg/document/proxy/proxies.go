@@ -1,10 +1,33 @@
 package proxy
 
 import (
+	"bytes"
+	gojson "encoding/json"
+	"errors"
+	"math"
+	time2 "time"
+
 	"github.com/hackerwins/yorkie/pkg/document/json"
 	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
 )
 
+var (
+	// ErrUnsupportedValue is returned when the given value can not be
+	// converted into an element.
+	ErrUnsupportedValue = errors.New("unsupported value")
+
+	// ErrIndexOutOfRange is returned when the given index is out of range.
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	// ErrElementNotFound is returned when the given element is not in the
+	// container.
+	ErrElementNotFound = errors.New("element not found")
+)
+
+// Text marks a string to be built as a Text instead of a string primitive
+// when it is given to SetValue or AddValue.
+type Text string
+
 func toOriginal(elem datatype.Element) datatype.Element {
 	switch elem := elem.(type) {
 	case *ObjectProxy:
@@ -19,3 +42,99 @@ func toOriginal(elem datatype.Element) datatype.Element {
 
 	panic("unsupported type")
 }
+
+// ValueOf returns the value of the given element in the form SetValue and
+// AddValue build elements from. Objects and arrays are converted into
+// map[string]interface{} and []interface{}, and Text into Text.
+func ValueOf(elem datatype.Element) interface{} {
+	switch elem := elem.(type) {
+	case *json.Object:
+		members := make(map[string]interface{})
+		for k, v := range elem.Members() {
+			members[k] = ValueOf(v)
+		}
+		return members
+	case *ObjectProxy:
+		return ValueOf(elem.Object)
+	case *json.Array:
+		values := make([]interface{}, 0, elem.Len())
+		for _, v := range elem.Elements() {
+			values = append(values, ValueOf(v))
+		}
+		return values
+	case *ArrayProxy:
+		return ValueOf(elem.Array)
+	case *datatype.Text:
+		return Text(elem.String())
+	case *TextProxy:
+		return Text(elem.Text.String())
+	case *datatype.Primitive:
+		return elem.Value()
+	}
+
+	return nil
+}
+
+// normalizeValue converts the given value into one of the types that
+// SetValue and AddValue build elements from: nil, bool, int, int64, float64,
+// string, []byte, time.Time, Text, map[string]interface{} and []interface{}.
+// Numbers without a fractional part are converted into integers like the
+// numbers of JavaScript.
+func normalizeValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil, bool, string, []byte, time2.Time, Text, map[string]interface{}, []interface{}:
+		return v, nil
+	case int:
+		return normalizeInteger(int64(v)), nil
+	case int8:
+		return int(v), nil
+	case int16:
+		return int(v), nil
+	case int32:
+		return int(v), nil
+	case int64:
+		return normalizeInteger(v), nil
+	case float32:
+		return normalizeFloat(float64(v)), nil
+	case float64:
+		return normalizeFloat(v), nil
+	case gojson.Number:
+		if i, err := v.Int64(); err == nil {
+			return normalizeInteger(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, ErrUnsupportedValue
+		}
+		return normalizeFloat(f), nil
+	case gojson.RawMessage:
+		decoder := gojson.NewDecoder(bytes.NewReader(v))
+		decoder.UseNumber()
+
+		var decoded interface{}
+		if err := decoder.Decode(&decoded); err != nil {
+			return nil, err
+		}
+		return normalizeValue(decoded)
+	}
+
+	return nil, ErrUnsupportedValue
+}
+
+// normalizeInteger returns the given integer as int if it fits in Integer,
+// otherwise as int64 for Long.
+func normalizeInteger(v int64) interface{} {
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return v
+	}
+	return int(v)
+}
+
+func normalizeFloat(v float64) interface{} {
+	// math.MaxInt64 is rounded up to 2^63 as a float64, so the upper bound is
+	// exclusive.
+	if v == math.Trunc(v) && v >= math.MinInt64 && v < 1<<63 {
+		return normalizeInteger(int64(v))
+	}
+	return v
+}
@@ -0,0 +1,228 @@
+package schema
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hackerwins/yorkie/pkg/document/json"
+	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
+	"github.com/hackerwins/yorkie/pkg/document/proxy"
+)
+
+// The types of the values that Schema.Type can name. String accepts both
+// string primitives and texts because both are strings in JSON, and Number
+// accepts integers as well.
+const (
+	Object  = "object"
+	Array   = "array"
+	String  = "string"
+	Text    = "text"
+	Integer = "integer"
+	Number  = "number"
+	Boolean = "boolean"
+	Null    = "null"
+	Bytes   = "bytes"
+	Date    = "date"
+)
+
+var (
+	// ErrInvalidSchema is returned when the given schema is malformed.
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+)
+
+// Schema is a JSON-Schema-like description of a document. It supports a
+// subset of JSON Schema: type, properties, required, additionalProperties and
+// items. The empty type accepts any value.
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+}
+
+// ViolationError is returned when a document does not conform to the schema.
+// Path is the JSON Pointer of the element that violates the schema.
+type ViolationError struct {
+	Path   string
+	Reason string
+}
+
+func (e *ViolationError) Error() string {
+	path := e.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("schema violation at %s: %s", path, e.Reason)
+}
+
+// Parse decodes the given JSON into a schema.
+func Parse(data []byte) (*Schema, error) {
+	s := &Schema{}
+	if err := gojson.Unmarshal(data, s); err != nil {
+		return nil, ErrInvalidSchema
+	}
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Validate returns a ViolationError if the given root object of a document
+// does not conform to this schema.
+func (s *Schema) Validate(root *json.Object) error {
+	return s.validate("", root)
+}
+
+func (s *Schema) check() error {
+	switch s.Type {
+	case "", Object, Array, String, Text, Integer, Number, Boolean, Null, Bytes, Date:
+	default:
+		return ErrInvalidSchema
+	}
+
+	for _, prop := range s.Properties {
+		if prop == nil {
+			return ErrInvalidSchema
+		}
+		if err := prop.check(); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.check()
+	}
+
+	return nil
+}
+
+func (s *Schema) validate(path string, elem datatype.Element) error {
+	elem = unwrap(elem)
+	if actual := typeOf(elem); s.Type != "" && !matches(s.Type, actual) {
+		return &ViolationError{
+			Path:   path,
+			Reason: fmt.Sprintf("expected %s but got %s", s.Type, actual),
+		}
+	}
+
+	switch elem := elem.(type) {
+	case *json.Object:
+		return s.validateObject(path, elem)
+	case *json.Array:
+		if s.Items == nil {
+			return nil
+		}
+		for i, child := range elem.Elements() {
+			if err := s.Items.validate(path+"/"+strconv.Itoa(i), child); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Schema) validateObject(path string, obj *json.Object) error {
+	members := obj.Members()
+	for _, k := range s.Required {
+		if _, ok := members[k]; !ok {
+			return &ViolationError{
+				Path:   path,
+				Reason: fmt.Sprintf("missing required property %q", k),
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		prop, ok := s.Properties[k]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return &ViolationError{
+					Path:   path,
+					Reason: fmt.Sprintf("unexpected property %q", k),
+				}
+			}
+			continue
+		}
+
+		if err := prop.validate(path+"/"+pointerEscaper.Replace(k), members[k]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unwrap returns the element wrapped by the given proxy. The clone given to
+// Document.Update holds the proxies of the elements created in the updater.
+func unwrap(elem datatype.Element) datatype.Element {
+	switch elem := elem.(type) {
+	case *proxy.ObjectProxy:
+		return elem.Object
+	case *proxy.ArrayProxy:
+		return elem.Array
+	case *proxy.TextProxy:
+		return elem.Text
+	}
+
+	return elem
+}
+
+// typeOf returns the type name of the given element.
+func typeOf(elem datatype.Element) string {
+	switch elem := elem.(type) {
+	case *json.Object:
+		return Object
+	case *json.Array:
+		return Array
+	case *datatype.Text:
+		return Text
+	case *datatype.Primitive:
+		switch elem.ValueType() {
+		case datatype.Null:
+			return Null
+		case datatype.Boolean:
+			return Boolean
+		case datatype.Integer, datatype.Long:
+			return Integer
+		case datatype.Double:
+			return Number
+		case datatype.String:
+			return String
+		case datatype.Bytes:
+			return Bytes
+		case datatype.Date:
+			return Date
+		}
+	}
+
+	return "unknown"
+}
+
+// matches returns whether the value of the given actual type is accepted by
+// the given expected type.
+func matches(expected, actual string) bool {
+	switch {
+	case expected == actual:
+		return true
+	case expected == String && actual == Text:
+		return true
+	case expected == Number && actual == Integer:
+		return true
+	}
+
+	return false
+}
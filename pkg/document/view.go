@@ -0,0 +1,331 @@
+package document
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	gotime "time"
+
+	"github.com/hackerwins/yorkie/pkg/document/json"
+	"github.com/hackerwins/yorkie/pkg/document/json/datatype"
+	"github.com/hackerwins/yorkie/pkg/document/time"
+)
+
+// View is a read-only view of an element of the document. Unlike the proxies
+// given to Update, reading through View does not create a change context nor
+// clone the document.
+//
+// The path given to Get and the typed getters consists of keys separated by
+// dots and indexes in brackets, e.g. "a.b[3].c". The empty path means the
+// element of the view itself.
+//
+// A view holds the document and the path of its element rather than the
+// element itself, so it reflects the changes applied to the document
+// afterwards including the root replaced with a snapshot. The elements of
+// arrays in the path are identified by their creation time, so a view keeps
+// pointing at the same element after elements are inserted or moved before
+// it.
+type View struct {
+	doc      *Document
+	segments []pathSegment
+}
+
+// Root returns the read-only view of the root object of this document.
+func (d *Document) Root() *View {
+	return &View{doc: d}
+}
+
+// Get returns the view of the element at the given path. It returns nil if
+// there is no element at the path or the path is malformed.
+func (v *View) Get(path string) *View {
+	segments, ok := parsePath(path)
+	if !ok {
+		return nil
+	}
+
+	elem, resolved := v.child(segments...).resolve()
+	if elem == nil {
+		return nil
+	}
+
+	return &View{doc: v.doc, segments: resolved}
+}
+
+// child returns the view of the element at the given segments from the
+// element of this view.
+func (v *View) child(segments ...pathSegment) *View {
+	joined := make([]pathSegment, 0, len(v.segments)+len(segments))
+	joined = append(joined, v.segments...)
+	joined = append(joined, segments...)
+	return &View{doc: v.doc, segments: joined}
+}
+
+// element returns the element of this view in the current root of the
+// document. It returns nil if the element no longer exists.
+func (v *View) element() datatype.Element {
+	elem, _ := v.resolve()
+	return elem
+}
+
+// resolve returns the element of this view in the current root of the
+// document and the segments of its path whose indexes are replaced with the
+// creation times of the elements. It returns nil if the element no longer
+// exists.
+func (v *View) resolve() (datatype.Element, []pathSegment) {
+	var elem datatype.Element = v.doc.root.Object()
+	resolved := make([]pathSegment, 0, len(v.segments))
+	for _, segment := range v.segments {
+		switch container := elem.(type) {
+		case *json.Object:
+			if segment.isIndex {
+				return nil, nil
+			}
+			elem = container.Get(segment.key)
+		case *json.Array:
+			if !segment.isIndex {
+				return nil, nil
+			}
+			index := segment.index
+			if segment.createdAt != nil {
+				index = container.IndexOf(segment.createdAt)
+			}
+			elem = container.Get(index)
+		default:
+			return nil, nil
+		}
+
+		if elem == nil {
+			return nil, nil
+		}
+
+		if segment.isIndex {
+			segment = pathSegment{isIndex: true, createdAt: elem.CreatedAt()}
+		}
+		resolved = append(resolved, segment)
+	}
+
+	return elem, resolved
+}
+
+// IsObject returns whether the element of this view is an object or not.
+func (v *View) IsObject() bool {
+	_, ok := v.element().(*json.Object)
+	return ok
+}
+
+// IsArray returns whether the element of this view is an array or not.
+func (v *View) IsArray() bool {
+	_, ok := v.element().(*json.Array)
+	return ok
+}
+
+// IsText returns whether the element of this view is a text or not.
+func (v *View) IsText() bool {
+	_, ok := v.element().(*datatype.Text)
+	return ok
+}
+
+// Keys returns the sorted keys of the object. It returns nil if the element
+// of this view is not an object.
+func (v *View) Keys() []string {
+	obj, ok := v.element().(*json.Object)
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+	for key := range obj.Members() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Elements returns the views of the elements of the array. It returns nil if
+// the element of this view is not an array.
+func (v *View) Elements() []*View {
+	elem, resolved := v.resolve()
+	arr, ok := elem.(*json.Array)
+	if !ok {
+		return nil
+	}
+
+	view := &View{doc: v.doc, segments: resolved}
+
+	var views []*View
+	for _, elem := range arr.Elements() {
+		views = append(views, view.child(pathSegment{isIndex: true, createdAt: elem.CreatedAt()}))
+	}
+
+	return views
+}
+
+// Len returns the number of members of the object, elements of the array or
+// characters of the text. It returns 0 for primitives.
+func (v *View) Len() int {
+	switch elem := v.element().(type) {
+	case *json.Object:
+		return elem.Len()
+	case *json.Array:
+		return elem.Len()
+	case *datatype.Text:
+		return len([]rune(elem.String()))
+	}
+
+	return 0
+}
+
+// Value returns the value of the primitive or the content of the text. It
+// returns nil for objects and arrays.
+func (v *View) Value() interface{} {
+	switch elem := v.element().(type) {
+	case *datatype.Primitive:
+		return elem.Value()
+	case *datatype.Text:
+		return elem.String()
+	}
+
+	return nil
+}
+
+// Marshal returns the JSON encoding of the element of this view. It returns
+// "null" if the element no longer exists.
+func (v *View) Marshal() string {
+	elem := v.element()
+	if elem == nil {
+		return "null"
+	}
+	return elem.Marshal()
+}
+
+// GetBool returns the bool at the given path.
+func (v *View) GetBool(path string) (bool, bool) {
+	p, ok := v.primitive(path)
+	if !ok {
+		return false, false
+	}
+	return p.AsBool()
+}
+
+// GetInteger returns the int at the given path.
+func (v *View) GetInteger(path string) (int, bool) {
+	p, ok := v.primitive(path)
+	if !ok {
+		return 0, false
+	}
+	return p.AsInteger()
+}
+
+// GetLong returns the int64 at the given path. Integer is also converted.
+func (v *View) GetLong(path string) (int64, bool) {
+	p, ok := v.primitive(path)
+	if !ok {
+		return 0, false
+	}
+	return p.AsLong()
+}
+
+// GetDouble returns the float64 at the given path. Integer and Long are also
+// converted.
+func (v *View) GetDouble(path string) (float64, bool) {
+	p, ok := v.primitive(path)
+	if !ok {
+		return 0, false
+	}
+	return p.AsDouble()
+}
+
+// GetString returns the string at the given path. The content of Text is
+// also returned.
+func (v *View) GetString(path string) (string, bool) {
+	target := v.Get(path)
+	if target == nil {
+		return "", false
+	}
+
+	switch elem := target.element().(type) {
+	case *datatype.Primitive:
+		return elem.AsString()
+	case *datatype.Text:
+		return elem.String(), true
+	}
+
+	return "", false
+}
+
+// GetBytes returns the []byte at the given path.
+func (v *View) GetBytes(path string) ([]byte, bool) {
+	p, ok := v.primitive(path)
+	if !ok {
+		return nil, false
+	}
+	return p.AsBytes()
+}
+
+// GetDate returns the time.Time at the given path.
+func (v *View) GetDate(path string) (gotime.Time, bool) {
+	p, ok := v.primitive(path)
+	if !ok {
+		return gotime.Time{}, false
+	}
+	return p.AsDate()
+}
+
+func (v *View) primitive(path string) (*datatype.Primitive, bool) {
+	target := v.Get(path)
+	if target == nil {
+		return nil, false
+	}
+
+	p, ok := target.element().(*datatype.Primitive)
+	return p, ok
+}
+
+// pathSegment is a key of an object or an element of an array in a path.
+// The element is identified by its creation time once the path is resolved,
+// otherwise by its index.
+type pathSegment struct {
+	key       string
+	index     int
+	createdAt *time.Ticket
+	isIndex   bool
+}
+
+// parsePath parses the given path like "a.b[3].c" into segments.
+func parsePath(path string) ([]pathSegment, bool) {
+	var segments []pathSegment
+	for i := 0; i < len(path); {
+		if path[i] == '[' {
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, false
+			}
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil || index < 0 {
+				return nil, false
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			i += end + 1
+		} else {
+			// keys are separated by dots except the first one.
+			if len(segments) > 0 {
+				if path[i] != '.' {
+					return nil, false
+				}
+				i++
+			}
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, false
+			}
+			segments = append(segments, pathSegment{key: path[i : i+end]})
+			i += end
+		}
+	}
+
+	return segments, true
+}
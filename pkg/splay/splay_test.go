@@ -47,4 +47,18 @@ func TestSplayTree(t *testing.T) {
 		assert.Equal(t, tree.IndexOf(nodeC), 5)
 		assert.Equal(t, tree.IndexOf(nodeD), 9)
 	})
+	t.Run("splay to root test", func(t *testing.T) {
+		tree := splay.NewTree()
+
+		nodeA := tree.Insert(newSplayNode("A2"))
+		tree.Insert(newSplayNode("B23"))
+		tree.Insert(newSplayNode("C234"))
+		nodeD := tree.Insert(newSplayNode("D2345"))
+
+		// the nodes on the right-right path should also reach the root.
+		tree.Splay(nodeA)
+		assert.Equal(t, "[14,2]A2[7,3]B23[4,4]C234[12,5]D2345", tree.AnnotatedString())
+		tree.Splay(nodeD)
+		assert.Equal(t, "[9,2]A2[7,3]B23[4,4]C234[14,5]D2345", tree.AnnotatedString())
+	})
 }
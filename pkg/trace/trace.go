@@ -0,0 +1,155 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/hackerwins/yorkie/pkg/log"
+)
+
+const (
+	// ExporterStdout writes spans to the standard output.
+	ExporterStdout = "stdout"
+
+	// ExporterOTLP sends spans to an OTLP collector over gRPC.
+	ExporterOTLP = "otlp"
+
+	instrumentationName = "github.com/hackerwins/yorkie"
+)
+
+var (
+	// DocumentKey is the attribute key of the document key.
+	DocumentKey = attribute.Key("yorkie.document.key")
+
+	// ClientID is the attribute key of the client ID.
+	ClientID = attribute.Key("yorkie.client.id")
+
+	// DocumentCount is the attribute key of the number of documents in a
+	// request.
+	DocumentCount = attribute.Key("yorkie.document.count")
+
+	// ChangeCount is the attribute key of the number of changes in a pack.
+	ChangeCount = attribute.Key("yorkie.change.count")
+
+	// PushedChangeCount is the attribute key of the number of pushed changes.
+	PushedChangeCount = attribute.Key("yorkie.change.pushed")
+
+	// PulledChangeCount is the attribute key of the number of pulled changes.
+	PulledChangeCount = attribute.Key("yorkie.change.pulled")
+
+	// ServerSeqFrom is the attribute key of the start of a serverSeq range.
+	ServerSeqFrom = attribute.Key("yorkie.server_seq.from")
+
+	// ServerSeqTo is the attribute key of the end of a serverSeq range.
+	ServerSeqTo = attribute.Key("yorkie.server_seq.to")
+
+	// Collection is the attribute key of the MongoDB collection.
+	Collection = attribute.Key("db.mongodb.collection")
+
+	errUnsupportedExporter = errors.New("unsupported trace exporter")
+)
+
+// Config is the configuration for tracing.
+type Config struct {
+	// Exporter is the name of the exporter, "stdout" or "otlp".
+	Exporter string `json:"Exporter"`
+
+	// OTLPEndpoint is the address of the OTLP collector, e.g. localhost:4317.
+	OTLPEndpoint string `json:"OTLPEndpoint"`
+
+	// ServiceName is the name of the service reported with spans.
+	ServiceName string `json:"ServiceName"`
+
+	// SampleRatio is the ratio of traces to be sampled. 0 means always.
+	SampleRatio float64 `json:"SampleRatio"`
+}
+
+// Init creates a tracer provider with the given configuration and registers
+// it globally with the W3C trace context propagator. The returned function
+// flushes and stops the provider.
+func Init(conf *Config) (func(ctx context.Context) error, error) {
+	exporter, err := newExporter(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if conf.SampleRatio > 0 && conf.SampleRatio < 1 {
+		sampler = sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))
+	}
+
+	serviceName := conf.ServiceName
+	if serviceName == "" {
+		serviceName = "yorkie"
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown, nil
+}
+
+// Start creates a span of the given name as a child of the span in the
+// given context.
+func Start(
+	ctx context.Context,
+	name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, oteltrace.Span) {
+	return otel.Tracer(instrumentationName).Start(
+		ctx,
+		name,
+		oteltrace.WithAttributes(attrs...),
+	)
+}
+
+// End ends the given span, recording the given error if it is not nil.
+func End(span oteltrace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SetAttributes sets the given attributes on the span in the given context.
+func SetAttributes(ctx context.Context, attrs ...attribute.KeyValue) {
+	oteltrace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+func newExporter(conf *Config) (sdktrace.SpanExporter, error) {
+	switch conf.Exporter {
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithInsecure()}
+		if conf.OTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(conf.OTLPEndpoint))
+		}
+		return otlptracegrpc.New(context.Background(), opts...)
+	}
+
+	log.Logger.Error(errUnsupportedExporter)
+	return nil, errUnsupportedExporter
+}
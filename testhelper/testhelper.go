@@ -7,6 +7,11 @@ import (
 
 	"github.com/hackerwins/yorkie/yorkie"
 	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
+	"github.com/hackerwins/yorkie/yorkie/housekeeping"
+)
+
+const (
+	TestAdminToken = "test-admin-token"
 )
 
 var (
@@ -18,6 +23,17 @@ var (
 			PingTimeoutSec:       5,
 			YorkieDatabase:       "yorkie-meta",
 		},
+		Housekeeping: &housekeeping.Config{
+			ClientDeactivationIntervalSec: 1,
+			ClientTTLSec:                  60,
+			SnapshotIntervalSec:           1,
+			SnapshotThreshold:             3,
+			DocumentPurgeIntervalSec:      1,
+			DocumentPurgeDelaySec:         1,
+			IndexVerificationIntervalSec:  1,
+			CandidatesLimit:               100,
+		},
+		AdminToken: TestAdminToken,
 	}
 )
 
@@ -25,9 +41,30 @@ func randBetween(min, max int) int {
 	return rand.Intn(max-min) + min
 }
 
+// TestConfig returns a copy of the configuration for the tests which can be
+// modified by a test.
+func TestConfig() *yorkie.Config {
+	conf := *testConfig
+	mongoConf := *testConfig.Mongo
+	housekeepingConf := *testConfig.Housekeeping
+	conf.Mongo = &mongoConf
+	conf.Housekeeping = &housekeepingConf
+	return &conf
+}
+
 func WithYorkie(t *testing.T, f func(*testing.T, *yorkie.Yorkie)) {
-	testConfig.Mongo.YorkieDatabase = fmt.Sprintf("yorkie-meta-%d", randBetween(0, 9999))
-	y, err := yorkie.New(testConfig)
+	WithYorkieConfig(t, TestConfig(), f)
+}
+
+// WithYorkieConfig starts Yorkie with the given configuration and calls the
+// given function with it.
+func WithYorkieConfig(
+	t *testing.T,
+	conf *yorkie.Config,
+	f func(*testing.T, *yorkie.Yorkie),
+) {
+	conf.Mongo.YorkieDatabase = fmt.Sprintf("yorkie-meta-%d", randBetween(0, 9999))
+	y, err := yorkie.New(conf)
 	if err != nil {
 		t.Fatal(err)
 	}
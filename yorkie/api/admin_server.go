@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hackerwins/yorkie/api"
+	"github.com/hackerwins/yorkie/api/converter"
+	"github.com/hackerwins/yorkie/pkg/trace"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
+	"github.com/hackerwins/yorkie/yorkie/clients"
+	"github.com/hackerwins/yorkie/yorkie/documents"
+	"github.com/hackerwins/yorkie/yorkie/types"
+)
+
+// AdminServer is the implementation of the Admin service. Its RPCs are served
+// by the same gRPC server as the Yorkie service and are only allowed to the
+// callers with the admin token.
+type AdminServer struct {
+	backend *backend.Backend
+}
+
+func (s *AdminServer) DeactivateClient(
+	ctx context.Context,
+	req *api.AdminDeactivateClientRequest,
+) (*api.AdminDeactivateClientResponse, error) {
+	trace.SetAttributes(ctx, trace.ClientID.String(req.ClientId))
+
+	clientInfo, err := clients.ForceDeactivate(ctx, s.backend, req.ClientId)
+	if err != nil {
+		return nil, toAdminStatusError(err)
+	}
+
+	pbClientInfo, err := clientInfo.ToPB()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.AdminDeactivateClientResponse{
+		ClientInfo: pbClientInfo,
+	}, nil
+}
+
+func (s *AdminServer) DetachDocument(
+	ctx context.Context,
+	req *api.AdminDetachDocumentRequest,
+) (*api.AdminDetachDocumentResponse, error) {
+	if req.DocumentKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "document key required")
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentKey.String(docKey.BSONKey()),
+	)
+
+	clientInfo, err := clients.ForceDetachDocument(ctx, s.backend, req.ClientId, docKey)
+	if err != nil {
+		return nil, toAdminStatusError(err)
+	}
+
+	pbClientInfo, err := clientInfo.ToPB()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.AdminDetachDocumentResponse{
+		ClientInfo: pbClientInfo,
+	}, nil
+}
+
+func (s *AdminServer) RemoveDocument(
+	ctx context.Context,
+	req *api.AdminRemoveDocumentRequest,
+) (*api.AdminRemoveDocumentResponse, error) {
+	if req.DocumentKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "document key required")
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+	trace.SetAttributes(ctx, trace.DocumentKey.String(docKey.BSONKey()))
+
+	docInfo, err := documents.Find(ctx, s.backend, docKey)
+	if err != nil {
+		return nil, toAdminStatusError(err)
+	}
+	if err := documents.Purge(ctx, s.backend, docInfo); err != nil {
+		return nil, toAdminStatusError(err)
+	}
+
+	pbDocInfo, err := docInfo.ToPB()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.AdminRemoveDocumentResponse{
+		DocumentInfo: pbDocInfo,
+	}, nil
+}
+
+func toAdminStatusError(err error) error {
+	switch err {
+	case mongo.ErrClientNotFound, mongo.ErrDocumentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case types.ErrDocumentNotAttached:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
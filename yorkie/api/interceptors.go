@@ -2,11 +2,23 @@ package api
 
 import (
 	"context"
+	"crypto/subtle"
+	"runtime/debug"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
+)
+
+const (
+	adminServicePrefix = "/api.Admin/"
+	adminTokenKey      = "authorization"
 )
 
 func unaryInterceptor(
@@ -15,8 +27,10 @@ func unaryInterceptor(
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
+	ctx, span := trace.StartServerSpan(ctx, info.FullMethod)
 	start := time.Now()
 	resp, err := handler(ctx, req)
+	trace.End(span, err)
 	if err == nil {
 		log.Logger.Infof("RPC : %q %s", info.FullMethod, time.Since(start))
 	} else {
@@ -26,6 +40,34 @@ func unaryInterceptor(
 	return resp, err
 }
 
+// newAdminAuthInterceptor creates an interceptor that rejects the calls of the
+// Admin service without the given admin token. If the token is empty, the
+// Admin service is disabled.
+func newAdminAuthInterceptor(adminToken string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, adminServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		if adminToken == "" {
+			return nil, status.Error(codes.PermissionDenied, "admin service is disabled")
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get(adminTokenKey)
+		if len(tokens) != 1 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(adminToken)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
 func streamInterceptor(
 	srv interface{},
 	ss grpc.ServerStream,
@@ -43,3 +85,39 @@ func streamInterceptor(
 
 	return err
 }
+
+// recoveryUnaryInterceptor turns a panic of the handler into an Internal
+// error so that a bad request does not take the agent down.
+func recoveryUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Logger.Errorf("RPC : %q panicked: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor turns a panic of the handler into an Internal
+// error like recoveryUnaryInterceptor.
+func recoveryStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Logger.Errorf("stream %q panicked: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(srv, ss)
+}
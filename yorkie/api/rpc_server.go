@@ -2,19 +2,30 @@ package api
 
 import (
 	"context"
+	gojson "encoding/json"
 	"fmt"
 	"net"
 
+	pbtypes "github.com/gogo/protobuf/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/hackerwins/yorkie/api"
 	"github.com/hackerwins/yorkie/api/converter"
+	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/pkg/document/change"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/document/schema"
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
 	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
 	"github.com/hackerwins/yorkie/yorkie/clients"
+	"github.com/hackerwins/yorkie/yorkie/documents"
 	"github.com/hackerwins/yorkie/yorkie/packs"
+	"github.com/hackerwins/yorkie/yorkie/types"
 )
 
 type RPCServer struct {
@@ -23,10 +34,17 @@ type RPCServer struct {
 	backend    *backend.Backend
 }
 
-func NewRPCServer(port int, be *backend.Backend) (*RPCServer, error) {
+func NewRPCServer(port int, adminToken string, be *backend.Backend) (*RPCServer, error) {
 	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(unaryInterceptor),
-		grpc.StreamInterceptor(streamInterceptor),
+		grpc.ChainUnaryInterceptor(
+			unaryInterceptor,
+			newAdminAuthInterceptor(adminToken),
+			recoveryUnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			streamInterceptor,
+			recoveryStreamInterceptor,
+		),
 	}
 
 	rpcServer := &RPCServer{
@@ -35,6 +53,7 @@ func NewRPCServer(port int, be *backend.Backend) (*RPCServer, error) {
 		backend:    be,
 	}
 	api.RegisterYorkieServer(rpcServer.grpcServer, rpcServer)
+	api.RegisterAdminServer(rpcServer.grpcServer, &AdminServer{backend: be})
 
 	return rpcServer, nil
 }
@@ -70,6 +89,7 @@ func (s *RPCServer) DeactivateClient(
 	ctx context.Context,
 	req *api.DeactivateClientRequest,
 ) (*api.DeactivateClientResponse, error) {
+	trace.SetAttributes(ctx, trace.ClientID.String(req.ClientId))
 	client, err := clients.Deactivate(ctx, s.backend, req.ClientId)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -80,6 +100,24 @@ func (s *RPCServer) DeactivateClient(
 	}, nil
 }
 
+func (s *RPCServer) RenewClient(
+	ctx context.Context,
+	req *api.RenewClientRequest,
+) (*api.RenewClientResponse, error) {
+	trace.SetAttributes(ctx, trace.ClientID.String(req.ClientId))
+	client, err := clients.Renew(ctx, s.backend, req.ClientId)
+	if err != nil {
+		if err == types.ErrClientNotActivated {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.RenewClientResponse{
+		ClientId: client.ID.Hex(),
+	}, nil
+}
+
 func (s *RPCServer) AttachDocument(
 	ctx context.Context,
 	req *api.AttachDocumentRequest,
@@ -88,22 +126,26 @@ func (s *RPCServer) AttachDocument(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentKey.String(pack.DocumentKey.BSONKey()),
+		trace.ChangeCount.Int(len(pack.Changes)),
+	)
 
-	clientInfo, docInfo, err := clients.FindClientAndDocument(ctx, s.backend, req.ClientId, pack)
+	pulled, err := s.attachDocument(ctx, req.ClientId, pack, req.Mode, req.ReadOnly)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if err := clientInfo.AttachDocument(docInfo.ID, pack.Checkpoint); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
-	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack)
+	docSchema, err := s.schemaOf(pack.DocumentKey)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	return &api.AttachDocumentResponse{
 		ChangePack: converter.ToChangePack(pulled),
+		Schema:     docSchema,
 	}, nil
 }
 
@@ -115,18 +157,16 @@ func (s *RPCServer) DetachDocument(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentKey.String(pack.DocumentKey.BSONKey()),
+		trace.ChangeCount.Int(len(pack.Changes)),
+	)
 
-	clientInfo, docInfo, err := clients.FindClientAndDocument(ctx, s.backend, req.ClientId, pack)
+	pulled, err := s.detachDocument(ctx, req.ClientId, pack)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if err := clientInfo.CheckDocumentAttached(docInfo.ID.Hex()); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
 	return &api.DetachDocumentResponse{
@@ -142,25 +182,441 @@ func (s *RPCServer) PushPull(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentKey.String(pack.DocumentKey.BSONKey()),
+		trace.ChangeCount.Int(len(pack.Changes)),
+	)
+
+	pulled, err := s.pushPull(ctx, req.ClientId, pack, req.PushOnly)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &api.PushPullResponse{
+		ChangePack: converter.ToChangePack(pulled),
+	}, nil
+}
+
+// AttachDocuments attaches the documents of the given packs in order. A
+// document that fails to be attached is returned as a DocumentError without
+// failing the others.
+func (s *RPCServer) AttachDocuments(
+	ctx context.Context,
+	req *api.AttachDocumentsRequest,
+) (*api.AttachDocumentsResponse, error) {
+	reqPacks, err := converter.FromChangePacks(req.ChangePacks)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentCount.Int(len(reqPacks)),
+	)
+
+	var pulledPacks []*change.Pack
+	var docErrors []*api.DocumentError
+	docSchemas := make(map[string]string)
+	for _, pack := range reqPacks {
+		pulled, err := s.attachDocument(ctx, req.ClientId, pack, req.Mode, req.ReadOnly)
+		if err != nil {
+			docErrors = append(docErrors, toDocumentError(pack.DocumentKey, err))
+			continue
+		}
+		pulledPacks = append(pulledPacks, pulled)
+
+		docSchema, err := s.schemaOf(pack.DocumentKey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if docSchema != "" {
+			docSchemas[pack.DocumentKey.BSONKey()] = docSchema
+		}
+	}
+
+	return &api.AttachDocumentsResponse{
+		ClientId:    req.ClientId,
+		ChangePacks: converter.ToChangePacks(pulledPacks),
+		Errors:      docErrors,
+		Schemas:     docSchemas,
+	}, nil
+}
+
+// DetachDocuments detaches the documents of the given packs in order. A
+// document that fails to be detached is returned as a DocumentError without
+// failing the others.
+func (s *RPCServer) DetachDocuments(
+	ctx context.Context,
+	req *api.DetachDocumentsRequest,
+) (*api.DetachDocumentsResponse, error) {
+	reqPacks, err := converter.FromChangePacks(req.ChangePacks)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentCount.Int(len(reqPacks)),
+	)
+
+	var pulledPacks []*change.Pack
+	var docErrors []*api.DocumentError
+	for _, pack := range reqPacks {
+		pulled, err := s.detachDocument(ctx, req.ClientId, pack)
+		if err != nil {
+			docErrors = append(docErrors, toDocumentError(pack.DocumentKey, err))
+			continue
+		}
+		pulledPacks = append(pulledPacks, pulled)
+	}
+
+	return &api.DetachDocumentsResponse{
+		ClientId:    req.ClientId,
+		ChangePacks: converter.ToChangePacks(pulledPacks),
+		Errors:      docErrors,
+	}, nil
+}
 
-	clientInfo, docInfo, err := clients.FindClientAndDocument(ctx, s.backend, req.ClientId, pack)
+// PushPullDocuments pushes and pulls the changes of the documents of the given
+// packs in order. The removed documents are skipped and returned separately,
+// and a document that fails otherwise is returned as a DocumentError, so that
+// they do not block the synchronization of the others.
+func (s *RPCServer) PushPullDocuments(
+	ctx context.Context,
+	req *api.PushPullDocumentsRequest,
+) (*api.PushPullDocumentsResponse, error) {
+	reqPacks, err := converter.FromChangePacks(req.ChangePacks)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentCount.Int(len(reqPacks)),
+	)
+
+	var pulledPacks []*change.Pack
+	var removedDocKeys []*api.DocumentKey
+	var docErrors []*api.DocumentError
+	for _, pack := range reqPacks {
+		pulled, err := s.pushPull(ctx, req.ClientId, pack, req.PushOnly)
+		if err == types.ErrDocumentRemoved {
+			removedDocKeys = append(removedDocKeys, converter.ToDocumentKey(pack.DocumentKey))
+			continue
+		}
+		if err != nil {
+			docErrors = append(docErrors, toDocumentError(pack.DocumentKey, err))
+			continue
+		}
+		pulledPacks = append(pulledPacks, pulled)
+	}
+
+	return &api.PushPullDocumentsResponse{
+		ClientId:            req.ClientId,
+		ChangePacks:         converter.ToChangePacks(pulledPacks),
+		RemovedDocumentKeys: removedDocKeys,
+		Errors:              docErrors,
+	}, nil
+}
+
+func (s *RPCServer) RemoveDocument(
+	ctx context.Context,
+	req *api.RemoveDocumentRequest,
+) (*api.RemoveDocumentResponse, error) {
+	if req.DocumentKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "document key required")
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+	trace.SetAttributes(
+		ctx,
+		trace.ClientID.String(req.ClientId),
+		trace.DocumentKey.String(docKey.BSONKey()),
+	)
+
+	if _, err := clients.RemoveDocument(ctx, s.backend, req.ClientId, docKey); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &api.RemoveDocumentResponse{
+		ClientId: req.ClientId,
+	}, nil
+}
+
+// ListDocuments returns the documents of the given collection whose name
+// starts with the given prefix in the order of the name.
+func (s *RPCServer) ListDocuments(
+	ctx context.Context,
+	req *api.ListDocumentsRequest,
+) (*api.ListDocumentsResponse, error) {
+	if req.Collection == "" {
+		return nil, status.Error(codes.InvalidArgument, "collection required")
+	}
+
+	docInfos, err := documents.ListByPrefix(
+		ctx,
+		s.backend,
+		req.Collection,
+		req.Prefix,
+		req.After,
+		int64(req.Limit),
+	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if err := clientInfo.CheckDocumentAttached(docInfo.ID.Hex()); err != nil {
+
+	var pbDocInfos []*api.DocumentInfo
+	for _, docInfo := range docInfos {
+		pbDocInfo, err := docInfo.ToPB()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		pbDocInfos = append(pbDocInfos, pbDocInfo)
+	}
+
+	return &api.ListDocumentsResponse{
+		DocumentInfos: pbDocInfos,
+	}, nil
+}
+
+func (s *RPCServer) ListChanges(
+	ctx context.Context,
+	req *api.ListChangesRequest,
+) (*api.ListChangesResponse, error) {
+	if req.DocumentKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "document key required")
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+	trace.SetAttributes(
+		ctx,
+		trace.DocumentKey.String(docKey.BSONKey()),
+		trace.ServerSeqFrom.Int64(int64(req.FromServerSeq)),
+		trace.ServerSeqTo.Int64(int64(req.ToServerSeq)),
+	)
+
+	changes, err := documents.FindChanges(
+		ctx,
+		s.backend,
+		docKey,
+		req.FromServerSeq,
+		req.ToServerSeq,
+		req.Limit,
+	)
+	if err != nil {
+		switch err {
+		case mongo.ErrDocumentNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case documents.ErrInvalidServerSeqRange:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case documents.ErrChangesCompacted:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack)
+	return &api.ListChangesResponse{
+		Changes: converter.ToChanges(changes),
+	}, nil
+}
+
+func (s *RPCServer) MaterializeDocument(
+	ctx context.Context,
+	req *api.MaterializeDocumentRequest,
+) (*api.MaterializeDocumentResponse, error) {
+	if req.DocumentKey == nil {
+		return nil, status.Error(codes.InvalidArgument, "document key required")
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+	trace.SetAttributes(
+		ctx,
+		trace.DocumentKey.String(docKey.BSONKey()),
+		trace.ServerSeqTo.Int64(int64(req.ServerSeq)),
+	)
+
+	var doc *document.Document
+	var err error
+	if req.Timestamp == nil {
+		doc, err = documents.Materialize(ctx, s.backend, docKey, req.ServerSeq)
+	} else {
+		at, tsErr := pbtypes.TimestampFromProto(req.Timestamp)
+		if tsErr != nil {
+			return nil, status.Error(codes.InvalidArgument, tsErr.Error())
+		}
+		doc, err = documents.MaterializeAt(ctx, s.backend, docKey, at)
+	}
 	if err != nil {
+		switch err {
+		case mongo.ErrDocumentNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case documents.ErrChangesCompacted:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &api.PushPullResponse{
-		ChangePack: converter.ToChangePack(pulled),
+	return &api.MaterializeDocumentResponse{
+		ServerSeq: doc.Checkpoint().ServerSeq,
+		Snapshot:  doc.Marshal(),
 	}, nil
 }
 
+// attachDocument attaches the document of the given pack to the given client
+// and pushes and pulls its changes.
+func (s *RPCServer) attachDocument(
+	ctx context.Context,
+	clientID string,
+	pack *change.Pack,
+	mode api.AttachMode,
+	readOnly bool,
+) (*change.Pack, error) {
+	var clientInfo *types.ClientInfo
+	var docInfo *types.DocInfo
+	var err error
+	switch mode {
+	case api.AttachMode_CREATE_OR_FAIL:
+		clientInfo, docInfo, err = clients.FindClientAndCreateDocument(ctx, s.backend, clientID, pack)
+	case api.AttachMode_ATTACH_EXISTING:
+		clientInfo, docInfo, err = clients.FindClientAndDocument(ctx, s.backend, clientID, pack, false)
+	default:
+		clientInfo, docInfo, err = clients.FindClientAndDocument(ctx, s.backend, clientID, pack, true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := clientInfo.AttachDocument(docInfo.ID, pack.Checkpoint, readOnly); err != nil {
+		return nil, err
+	}
+
+	return packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack, false)
+}
+
+// schemaOf returns the JSON of the schema of the given document so that the
+// client can validate its changes before pushing them. It returns the empty
+// string if the document has no schema.
+func (s *RPCServer) schemaOf(docKey *key.Key) (string, error) {
+	docSchema := s.backend.FindSchema(docKey)
+	if docSchema == nil {
+		return "", nil
+	}
+
+	data, err := gojson.Marshal(docSchema)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// detachDocument pushes and pulls the changes of the document of the given
+// pack and then detaches it from the given client.
+func (s *RPCServer) detachDocument(
+	ctx context.Context,
+	clientID string,
+	pack *change.Pack,
+) (*change.Pack, error) {
+	clientInfo, docInfo, err := clients.FindClientAndDocument(ctx, s.backend, clientID, pack, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := clientInfo.CheckDocumentAttached(docInfo.ID.Hex()); err != nil {
+		return nil, err
+	}
+
+	pulled, err := packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := clientInfo.DetachDocument(docInfo.ID, pulled.Checkpoint); err != nil {
+		return nil, err
+	}
+	if err := s.backend.Mongo.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
+		return nil, err
+	}
+
+	return pulled, nil
+}
+
+// pushPull pushes and pulls the changes of the document of the given pack
+// which is attached to the given client. If pushOnly is true, the changes are
+// only pushed.
+func (s *RPCServer) pushPull(
+	ctx context.Context,
+	clientID string,
+	pack *change.Pack,
+	pushOnly bool,
+) (*change.Pack, error) {
+	clientInfo, docInfo, err := clients.FindClientAndDocument(ctx, s.backend, clientID, pack, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := clientInfo.CheckDocumentAttached(docInfo.ID.Hex()); err != nil {
+		return nil, err
+	}
+
+	return packs.PushPull(ctx, s.backend, clientInfo, docInfo, pack, pushOnly)
+}
+
+// toStatusError converts the given error of finding clients and documents to
+// a gRPC status error.
+func toStatusError(err error) error {
+	if _, ok := err.(*schema.ViolationError); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	switch err {
+	case mongo.ErrClientNotFound, mongo.ErrDocumentNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case mongo.ErrDocumentAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case types.ErrClientNotActivated, types.ErrDocumentNotAttached, types.ErrDocumentAlreadyAttached:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case types.ErrDocumentRemoved:
+		return statusErrorWithReason(codes.FailedPrecondition, err, converter.ReasonDocumentRemoved)
+	case types.ErrDocumentReadOnly:
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// toDocumentError converts the given error of the document of the given key in
+// a batch request to DocumentError with the same code and reason as
+// toStatusError.
+func toDocumentError(docKey *key.Key, err error) *api.DocumentError {
+	st := status.Convert(toStatusError(err))
+	docErr := &api.DocumentError{
+		DocumentKey: converter.ToDocumentKey(docKey),
+		Code:        uint32(st.Code()),
+		Message:     st.Message(),
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			docErr.Reason = info.Reason
+		}
+	}
+
+	return docErr
+}
+
+// statusErrorWithReason returns a gRPC status error of the given code with
+// ErrorInfo details of the given reason, so that clients can tell the error
+// from the others of the same code without parsing the message.
+func statusErrorWithReason(code codes.Code, err error, reason string) error {
+	st := status.New(code, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Domain: converter.ErrorDomain,
+		Reason: reason,
+	})
+	if detailErr != nil {
+		log.Logger.Error(detailErr)
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
 func (s *RPCServer) listenAndServeGRPC() error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
 	if err != nil {
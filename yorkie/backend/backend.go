@@ -1,11 +1,40 @@
 package backend
 
 import (
+	"container/list"
+	"sync"
+
+	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/document/schema"
 	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
 )
 
+// validatedDocsCapacity is the maximum number of documents kept in the cache
+// of validated documents. The least recently used one is evicted first, and
+// it is rebuilt from the latest snapshot when it is needed again.
+const validatedDocsCapacity = 1000
+
+// validatedDoc is an entry of the cache of validated documents.
+type validatedDoc struct {
+	docID string
+	doc   *document.Document
+}
+
 type Backend struct {
 	Mongo *mongo.Client
+
+	// Schemas are the schemas that the documents should conform to, keyed by
+	// the collection or the BSON key of the document.
+	Schemas map[string]*schema.Schema
+
+	// validatedDocs caches the latest state of the documents validated
+	// against the schemas, keyed by the ID of the document, so that pushed
+	// changes are validated without rebuilding the document every time. The
+	// list keeps the entries in the order of use, the most recent first.
+	validatedDocs    map[string]*list.Element
+	validatedDocList *list.List
+	validatedDocsMu  sync.Mutex
 }
 
 func New(conf *mongo.Config) (*Backend, error) {
@@ -16,10 +45,66 @@ func New(conf *mongo.Config) (*Backend, error) {
 	}
 
 	return &Backend{
-		Mongo: client,
+		Mongo:            client,
+		Schemas:          make(map[string]*schema.Schema),
+		validatedDocs:    make(map[string]*list.Element),
+		validatedDocList: list.New(),
 	}, nil
 }
 
+// FindSchema returns the schema of the given document. The schema of the
+// document takes precedence over the one of its collection. It returns nil if
+// neither exists.
+func (b *Backend) FindSchema(docKey *key.Key) *schema.Schema {
+	if s, ok := b.Schemas[docKey.BSONKey()]; ok {
+		return s
+	}
+
+	return b.Schemas[docKey.Collection]
+}
+
+// TakeValidatedDocument removes the cached state of the given document from
+// the cache and returns it, so that the caller can update it without sharing
+// it with others. It returns nil if there is no cached state.
+func (b *Backend) TakeValidatedDocument(docID string) *document.Document {
+	b.validatedDocsMu.Lock()
+	defer b.validatedDocsMu.Unlock()
+
+	elem, ok := b.validatedDocs[docID]
+	if !ok {
+		return nil
+	}
+
+	delete(b.validatedDocs, docID)
+	b.validatedDocList.Remove(elem)
+	return elem.Value.(*validatedDoc).doc
+}
+
+// CacheValidatedDocument caches the given state of the given document. The
+// state should include only the changes already stored. If the cache is full,
+// the least recently used document is evicted.
+func (b *Backend) CacheValidatedDocument(docID string, doc *document.Document) {
+	b.validatedDocsMu.Lock()
+	defer b.validatedDocsMu.Unlock()
+
+	if elem, ok := b.validatedDocs[docID]; ok {
+		elem.Value.(*validatedDoc).doc = doc
+		b.validatedDocList.MoveToFront(elem)
+		return
+	}
+
+	b.validatedDocs[docID] = b.validatedDocList.PushFront(&validatedDoc{
+		docID: docID,
+		doc:   doc,
+	})
+
+	for b.validatedDocList.Len() > validatedDocsCapacity {
+		oldest := b.validatedDocList.Back()
+		b.validatedDocList.Remove(oldest)
+		delete(b.validatedDocs, oldest.Value.(*validatedDoc).docID)
+	}
+}
+
 func (b *Backend) Close() error {
 	if err := b.Mongo.Close(); err != nil {
 		return err
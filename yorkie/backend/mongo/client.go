@@ -3,6 +3,7 @@ package mongo
 import (
 	"context"
 	"errors"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,12 +14,14 @@ import (
 
 	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
 	"github.com/hackerwins/yorkie/yorkie/types"
 )
 
 var (
-	ErrClientNotFound   = errors.New("fail to find the client")
-	ErrDocumentNotFound = errors.New("fail to find the document")
+	ErrClientNotFound        = errors.New("fail to find the client")
+	ErrDocumentNotFound      = errors.New("fail to find the document")
+	ErrDocumentAlreadyExists = errors.New("document already exists")
 )
 
 type Config struct {
@@ -81,7 +84,7 @@ func (c *Client) Close() error {
 
 func (c *Client) ActivateClient(ctx context.Context, key string) (*types.ClientInfo, error) {
 	clientInfo := types.ClientInfo{}
-	if err := c.withCollection(ColClientInfos, func(col *mongo.Collection) error {
+	if err := c.withCollection(ctx, "ActivateClient", ColClientInfos, func(col *mongo.Collection) error {
 		now := time.Now()
 		res, err := col.UpdateOne(ctx, bson.M{
 			"key": key,
@@ -126,7 +129,7 @@ func (c *Client) ActivateClient(ctx context.Context, key string) (*types.ClientI
 
 func (c *Client) DeactivateClient(ctx context.Context, clientID string) (*types.ClientInfo, error) {
 	clientInfo := types.ClientInfo{}
-	if err := c.withCollection(ColClientInfos, func(col *mongo.Collection) error {
+	if err := c.withCollection(ctx, "DeactivateClient", ColClientInfos, func(col *mongo.Collection) error {
 		id, err := primitive.ObjectIDFromHex(clientID)
 		if err != nil {
 			log.Logger.Error(err)
@@ -156,10 +159,115 @@ func (c *Client) DeactivateClient(ctx context.Context, clientID string) (*types.
 	return &clientInfo, nil
 }
 
+// RenewClient extends the lease of the given client by updating its
+// updated_at. It returns ErrClientNotActivated if the client is deactivated.
+func (c *Client) RenewClient(ctx context.Context, clientID string) (*types.ClientInfo, error) {
+	clientInfo := types.ClientInfo{}
+	if err := c.withCollection(ctx, "RenewClient", ColClientInfos, func(col *mongo.Collection) error {
+		id, err := primitive.ObjectIDFromHex(clientID)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		res := col.FindOneAndUpdate(ctx, bson.M{
+			"_id":    id,
+			"status": types.ClientActivated,
+		}, bson.M{
+			"$set": bson.M{
+				"updated_at": time.Now(),
+			},
+		}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+		if err := res.Decode(&clientInfo); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return types.ErrClientNotActivated
+			}
+			log.Logger.Error(err)
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &clientInfo, nil
+}
+
+// FindStaleClientInfos returns the activated clients which have not been
+// updated since the given time. If limit is greater than 0, at most limit
+// clients are returned.
+func (c *Client) FindStaleClientInfos(
+	ctx context.Context,
+	updatedBefore time.Time,
+	limit int64,
+) ([]*types.ClientInfo, error) {
+	var clientInfos []*types.ClientInfo
+
+	if err := c.withCollection(ctx, "FindStaleClientInfos", ColClientInfos, func(col *mongo.Collection) error {
+		opts := options.Find()
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{
+			"status": types.ClientActivated,
+			"updated_at": bson.M{
+				"$lt": updatedBefore,
+			},
+		}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &clientInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return clientInfos, nil
+}
+
+// FindAttachedClientInfos finds the activated clients to which the given
+// document is attached.
+func (c *Client) FindAttachedClientInfos(
+	ctx context.Context,
+	docID primitive.ObjectID,
+) ([]*types.ClientInfo, error) {
+	var clientInfos []*types.ClientInfo
+
+	if err := c.withCollection(ctx, "FindAttachedClientInfos", ColClientInfos, func(col *mongo.Collection) error {
+		cursor, err := col.Find(ctx, bson.M{
+			"status":                               types.ClientActivated,
+			"documents." + docID.Hex() + ".status": types.DocumentAttached,
+		})
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &clientInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return clientInfos, nil
+}
+
 func (c *Client) FindClientInfoByID(ctx context.Context, clientID string) (*types.ClientInfo, error) {
 	var client types.ClientInfo
 
-	if err := c.withCollection(ColClientInfos, func(col *mongo.Collection) error {
+	if err := c.withCollection(ctx, "FindClientInfoByID", ColClientInfos, func(col *mongo.Collection) error {
 		id, err := primitive.ObjectIDFromHex(clientID)
 		if err != nil {
 			log.Logger.Error(err)
@@ -185,12 +293,85 @@ func (c *Client) FindClientInfoByID(ctx context.Context, clientID string) (*type
 	return &client, nil
 }
 
+// ListClientInfos returns the clients in the order of creation. If limit is
+// greater than 0, at most limit clients are returned.
+func (c *Client) ListClientInfos(ctx context.Context, limit int64) ([]*types.ClientInfo, error) {
+	var clientInfos []*types.ClientInfo
+
+	if err := c.withCollection(ctx, "ListClientInfos", ColClientInfos, func(col *mongo.Collection) error {
+		opts := options.Find().SetSort(bson.M{"_id": 1})
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &clientInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return clientInfos, nil
+}
+
+// UpdateClientInfo updates the status and the documents of the given client.
+func (c *Client) UpdateClientInfo(ctx context.Context, clientInfo *types.ClientInfo) error {
+	return c.withCollection(ctx, "UpdateClientInfo", ColClientInfos, func(col *mongo.Collection) error {
+		result := col.FindOneAndUpdate(ctx, bson.M{
+			"_id": clientInfo.ID,
+		}, bson.M{
+			"$set": bson.M{
+				"status":     clientInfo.Status,
+				"documents":  clientInfo.Documents,
+				"updated_at": clientInfo.UpdatedAt,
+			},
+		})
+
+		if result.Err() != nil {
+			if result.Err() == mongo.ErrNoDocuments {
+				return ErrClientNotFound
+			}
+			log.Logger.Error(result.Err())
+			return result.Err()
+		}
+
+		return nil
+	})
+}
+
+// RemoveDocumentFromClientInfos removes the given document from the
+// documents of all the clients.
+func (c *Client) RemoveDocumentFromClientInfos(ctx context.Context, docID primitive.ObjectID) error {
+	return c.withCollection(ctx, "RemoveDocumentFromClientInfos", ColClientInfos, func(col *mongo.Collection) error {
+		field := "documents." + docID.Hex()
+		if _, err := col.UpdateMany(ctx, bson.M{
+			field: bson.M{"$exists": true},
+		}, bson.M{
+			"$unset": bson.M{field: ""},
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
 func (c *Client) UpdateClientInfoAfterPushPull(
 	ctx context.Context,
 	clientInfo *types.ClientInfo,
 	docInfo *types.DocInfo,
 ) error {
-	return c.withCollection(ColClientInfos, func(col *mongo.Collection) error {
+	return c.withCollection(ctx, "UpdateClientInfoAfterPushPull", ColClientInfos, func(col *mongo.Collection) error {
 		result := col.FindOneAndUpdate(ctx, bson.M{
 			"key": clientInfo.Key,
 		}, bson.M{
@@ -212,14 +393,33 @@ func (c *Client) UpdateClientInfoAfterPushPull(
 	})
 }
 
+// FindDocInfoByKey finds the document of the given key. If the document does
+// not exist and createDocIfNotExist is true, it creates a new document owned
+// by the given client.
 func (c *Client) FindDocInfoByKey(
 	ctx context.Context,
 	clientInfo *types.ClientInfo,
 	bsonDocKey string,
+	createDocIfNotExist bool,
 ) (*types.DocInfo, error) {
 	docInfo := types.DocInfo{}
 
-	if err := c.withCollection(ColDocInfos, func(col *mongo.Collection) error {
+	if err := c.withCollection(ctx, "FindDocInfoByKey", ColDocInfos, func(col *mongo.Collection) error {
+		if !createDocIfNotExist {
+			result := col.FindOne(ctx, bson.M{
+				"key": bsonDocKey,
+			})
+			if err := result.Decode(&docInfo); err != nil {
+				if err == mongo.ErrNoDocuments {
+					return ErrDocumentNotFound
+				}
+				log.Logger.Error(err)
+				return err
+			}
+
+			return nil
+		}
+
 		now := time.Now()
 		res, err := col.UpdateOne(ctx, bson.M{
 			"key": bsonDocKey,
@@ -262,6 +462,271 @@ func (c *Client) FindDocInfoByKey(
 	return &docInfo, nil
 }
 
+// CreateDocInfo creates a new document of the given key owned by the given
+// client. It returns ErrDocumentAlreadyExists if the document exists.
+func (c *Client) CreateDocInfo(
+	ctx context.Context,
+	clientInfo *types.ClientInfo,
+	bsonDocKey string,
+) (*types.DocInfo, error) {
+	now := time.Now()
+	docInfo := types.DocInfo{
+		Key:        bsonDocKey,
+		Owner:      clientInfo.ID,
+		CreatedAt:  now,
+		AccessedAt: now,
+	}
+
+	if err := c.withCollection(ctx, "CreateDocInfo", ColDocInfos, func(col *mongo.Collection) error {
+		res, err := col.InsertOne(ctx, bson.M{
+			"key":         docInfo.Key,
+			"owner":       docInfo.Owner,
+			"created_at":  docInfo.CreatedAt,
+			"accessed_at": docInfo.AccessedAt,
+		})
+		if err != nil {
+			if isDuplicateKeyError(err) {
+				return ErrDocumentAlreadyExists
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		docInfo.ID = res.InsertedID.(primitive.ObjectID)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
+// ImportDocInfo creates the document of the given key which already has
+// changes up to serverSeq, compacted up to compactedSeq. It is used to import
+// the document from another agent, so the document has no owner.
+func (c *Client) ImportDocInfo(
+	ctx context.Context,
+	bsonDocKey string,
+	serverSeq uint64,
+	compactedSeq uint64,
+) (*types.DocInfo, error) {
+	now := time.Now()
+	docInfo := types.DocInfo{
+		Key:          bsonDocKey,
+		ServerSeq:    serverSeq,
+		CompactedSeq: compactedSeq,
+		CreatedAt:    now,
+		AccessedAt:   now,
+		UpdatedAt:    now,
+	}
+
+	if err := c.withCollection(ctx, "ImportDocInfo", ColDocInfos, func(col *mongo.Collection) error {
+		res, err := col.InsertOne(ctx, bson.M{
+			"key":           docInfo.Key,
+			"server_seq":    docInfo.ServerSeq,
+			"compacted_seq": docInfo.CompactedSeq,
+			"created_at":    docInfo.CreatedAt,
+			"accessed_at":   docInfo.AccessedAt,
+			"updated_at":    docInfo.UpdatedAt,
+		})
+		if err != nil {
+			if isDuplicateKeyError(err) {
+				return ErrDocumentAlreadyExists
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		docInfo.ID = res.InsertedID.(primitive.ObjectID)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
+// RemoveDocInfo marks the given document as removed.
+func (c *Client) RemoveDocInfo(ctx context.Context, docID primitive.ObjectID) error {
+	return c.withCollection(ctx, "RemoveDocInfo", ColDocInfos, func(col *mongo.Collection) error {
+		now := time.Now()
+		if _, err := col.UpdateOne(ctx, bson.M{
+			"_id":        docID,
+			"removed_at": bson.M{"$exists": false},
+		}, bson.M{
+			"$set": bson.M{
+				"removed_at": now,
+				"updated_at": now,
+			},
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindRemovedDocInfos finds the documents removed before the given time. If
+// limit is greater than 0, at most limit documents are returned.
+func (c *Client) FindRemovedDocInfos(
+	ctx context.Context,
+	removedBefore time.Time,
+	limit int64,
+) ([]*types.DocInfo, error) {
+	var docInfos []*types.DocInfo
+
+	if err := c.withCollection(ctx, "FindRemovedDocInfos", ColDocInfos, func(col *mongo.Collection) error {
+		opts := options.Find()
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{
+			"removed_at": bson.M{
+				"$lt": removedBefore,
+			},
+		}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &docInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return docInfos, nil
+}
+
+// FindDocInfosByKeyPrefix finds the documents whose key starts with the given
+// prefix and is after the given key in the order of the key. The removed
+// documents are excluded.
+func (c *Client) FindDocInfosByKeyPrefix(
+	ctx context.Context,
+	bsonKeyPrefix string,
+	afterBSONKey string,
+	limit int64,
+) ([]*types.DocInfo, error) {
+	var docInfos []*types.DocInfo
+
+	if err := c.withCollection(ctx, "FindDocInfosByKeyPrefix", ColDocInfos, func(col *mongo.Collection) error {
+		opts := options.Find().SetSort(bson.M{"key": 1})
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{
+			"key": bson.M{
+				"$regex": "^" + regexp.QuoteMeta(bsonKeyPrefix),
+				"$gt":    afterBSONKey,
+			},
+			"removed_at": bson.M{
+				"$exists": false,
+			},
+		}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &docInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return docInfos, nil
+}
+
+// FindDocInfoByID finds the document of the given ID.
+func (c *Client) FindDocInfoByID(ctx context.Context, docID string) (*types.DocInfo, error) {
+	var docInfo types.DocInfo
+
+	if err := c.withCollection(ctx, "FindDocInfoByID", ColDocInfos, func(col *mongo.Collection) error {
+		id, err := primitive.ObjectIDFromHex(docID)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		result := col.FindOne(ctx, bson.M{
+			"_id": id,
+		})
+
+		if err := result.Decode(&docInfo); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return ErrDocumentNotFound
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
+// ListDocInfos returns the documents in the order of creation. If limit is
+// greater than 0, at most limit documents are returned.
+func (c *Client) ListDocInfos(ctx context.Context, limit int64) ([]*types.DocInfo, error) {
+	var docInfos []*types.DocInfo
+
+	if err := c.withCollection(ctx, "ListDocInfos", ColDocInfos, func(col *mongo.Collection) error {
+		opts := options.Find().SetSort(bson.M{"_id": 1})
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &docInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return docInfos, nil
+}
+
+// DeleteDocInfo deletes the document of the given ID.
+func (c *Client) DeleteDocInfo(ctx context.Context, docID primitive.ObjectID) error {
+	return c.withCollection(ctx, "DeleteDocInfo", ColDocInfos, func(col *mongo.Collection) error {
+		res, err := col.DeleteOne(ctx, bson.M{
+			"_id": docID,
+		})
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		if res.DeletedCount == 0 {
+			return ErrDocumentNotFound
+		}
+
+		return nil
+	})
+}
+
 func (c *Client) CreateChangeInfos(
 	ctx context.Context,
 	docID primitive.ObjectID,
@@ -271,9 +736,10 @@ func (c *Client) CreateChangeInfos(
 		return nil
 	}
 
-	return c.withCollection(ColChanges, func(col *mongo.Collection) error {
+	return c.withCollection(ctx, "CreateChangeInfos", ColChanges, func(col *mongo.Collection) error {
 		var bsonChanges []interface{}
 
+		now := time.Now()
 		for _, c := range changes {
 			bsonChanges = append(bsonChanges, bson.M{
 				"doc_id":     docID,
@@ -283,6 +749,7 @@ func (c *Client) CreateChangeInfos(
 				"lamport":    c.ID().Lamport(),
 				"message":    c.Message(),
 				"operations": types.EncodeOperation(c.Operations()),
+				"created_at": now,
 			})
 		}
 
@@ -301,7 +768,7 @@ func (c *Client) UpdateDocInfo(
 	clientInfo *types.ClientInfo,
 	docInfo *types.DocInfo,
 ) error {
-	return c.withCollection(ColDocInfos, func(col *mongo.Collection) error {
+	return c.withCollection(ctx, "UpdateDocInfo", ColDocInfos, func(col *mongo.Collection) error {
 		now := time.Now()
 		_, err := col.UpdateOne(ctx, bson.M{
 			"_id": docInfo.ID,
@@ -333,14 +800,14 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 ) ([]*change.Change, error) {
 	var changes []*change.Change
 
-	if err := c.withCollection(ColChanges, func(col *mongo.Collection) error {
+	if err := c.withCollection(ctx, "FindChangeInfosBetweenServerSeqs", ColChanges, func(col *mongo.Collection) error {
 		cursor, err := col.Find(ctx, bson.M{
 			"doc_id": docID,
 			"server_seq": bson.M{
 				"$gte": from,
 				"$lte": to,
 			},
-		}, options.Find())
+		}, options.Find().SetSort(bson.M{"server_seq": 1}))
 		if err != nil {
 			log.Logger.Error(err)
 			return err
@@ -379,14 +846,351 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 	return changes, nil
 }
 
+// DeleteChangeInfos deletes all the changes of the given document.
+func (c *Client) DeleteChangeInfos(ctx context.Context, docID primitive.ObjectID) error {
+	return c.withCollection(ctx, "DeleteChangeInfos", ColChanges, func(col *mongo.Collection) error {
+		if _, err := col.DeleteMany(ctx, bson.M{
+			"doc_id": docID,
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// CompactChangeInfos deletes the changes of the given document up to the
+// given serverSeq and records the serverSeq as the compacted one. The
+// snapshots taken before the serverSeq are deleted together because the
+// changes after them are no longer available.
+func (c *Client) CompactChangeInfos(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	serverSeq uint64,
+) error {
+	if err := c.withCollection(ctx, "CompactChangeInfos", ColDocInfos, func(col *mongo.Collection) error {
+		if _, err := col.UpdateOne(ctx, bson.M{
+			"_id": docID,
+		}, bson.M{
+			"$max": bson.M{
+				"compacted_seq": serverSeq,
+			},
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := c.withCollection(ctx, "CompactChangeInfos", ColChanges, func(col *mongo.Collection) error {
+		if _, err := col.DeleteMany(ctx, bson.M{
+			"doc_id": docID,
+			"server_seq": bson.M{
+				"$lte": serverSeq,
+			},
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return c.withCollection(ctx, "CompactChangeInfos", ColSnapshots, func(col *mongo.Collection) error {
+		if _, err := col.DeleteMany(ctx, bson.M{
+			"doc_id": docID,
+			"server_seq": bson.M{
+				"$lt": serverSeq,
+			},
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// DeleteSnapshotInfos deletes all the snapshots of the given document.
+func (c *Client) DeleteSnapshotInfos(ctx context.Context, docID primitive.ObjectID) error {
+	return c.withCollection(ctx, "DeleteSnapshotInfos", ColSnapshots, func(col *mongo.Collection) error {
+		if _, err := col.DeleteMany(ctx, bson.M{
+			"doc_id": docID,
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindLastServerSeqBefore returns the serverSeq of the last change of the
+// given document stored at or before the given time. It returns 0 if there
+// is no such change.
+func (c *Client) FindLastServerSeqBefore(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	at time.Time,
+) (uint64, error) {
+	var changeInfo types.ChangeInfo
+
+	if err := c.withCollection(ctx, "FindLastServerSeqBefore", ColChanges, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"doc_id": docID,
+			"created_at": bson.M{
+				"$lte": at,
+			},
+		}, options.FindOne().SetSort(bson.M{"server_seq": -1}))
+
+		if err := result.Decode(&changeInfo); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return changeInfo.ServerSeq, nil
+}
+
+// FindDocInfosUpdatedAfter returns the documents updated after the given
+// document in the order of update and ID. The given document is identified by
+// its update time and ID, so the documents updated at the same time are not
+// skipped between pages. If limit is greater than 0, at most limit documents
+// are returned.
+func (c *Client) FindDocInfosUpdatedAfter(
+	ctx context.Context,
+	updatedAfter time.Time,
+	afterID primitive.ObjectID,
+	limit int64,
+) ([]*types.DocInfo, error) {
+	var docInfos []*types.DocInfo
+
+	if err := c.withCollection(ctx, "FindDocInfosUpdatedAfter", ColDocInfos, func(col *mongo.Collection) error {
+		opts := options.Find().SetSort(bson.D{
+			{Key: "updated_at", Value: 1},
+			{Key: "_id", Value: 1},
+		})
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{
+			"$or": bson.A{
+				bson.M{"updated_at": bson.M{"$gt": updatedAfter}},
+				bson.M{
+					"updated_at": updatedAfter,
+					"_id":        bson.M{"$gt": afterID},
+				},
+			},
+		}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &docInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return docInfos, nil
+}
+
+// CreateSnapshotInfo stores the snapshot of the given document taken at the
+// given serverSeq. Storing the same snapshot again is ignored.
+func (c *Client) CreateSnapshotInfo(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	serverSeq uint64,
+	snapshot []byte,
+) error {
+	return c.withCollection(ctx, "CreateSnapshotInfo", ColSnapshots, func(col *mongo.Collection) error {
+		if _, err := col.InsertOne(ctx, bson.M{
+			"doc_id":     docID,
+			"server_seq": serverSeq,
+			"snapshot":   snapshot,
+			"created_at": time.Now(),
+		}); err != nil {
+			if isDuplicateKeyError(err) {
+				return nil
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindDocInfosAfterID finds the documents whose IDs are greater than the
+// given ID in the order of ID. It is used to visit all the documents in
+// several steps.
+func (c *Client) FindDocInfosAfterID(
+	ctx context.Context,
+	afterID primitive.ObjectID,
+	limit int64,
+) ([]*types.DocInfo, error) {
+	var docInfos []*types.DocInfo
+
+	if err := c.withCollection(ctx, "FindDocInfosAfterID", ColDocInfos, func(col *mongo.Collection) error {
+		opts := options.Find().SetSort(bson.M{"_id": 1})
+		if limit > 0 {
+			opts = opts.SetLimit(limit)
+		}
+
+		cursor, err := col.Find(ctx, bson.M{
+			"_id": bson.M{
+				"$gt": afterID,
+			},
+		}, opts)
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if err := cursor.All(ctx, &docInfos); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return docInfos, nil
+}
+
+// FindLastSnapshotInfo returns the last snapshot of the given document taken
+// at or before the given serverSeq. If there is no such snapshot, it returns
+// an empty snapshot whose serverSeq is 0.
+func (c *Client) FindLastSnapshotInfo(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	serverSeq uint64,
+) (*types.SnapshotInfo, error) {
+	snapshotInfo := types.SnapshotInfo{}
+
+	if err := c.withCollection(ctx, "FindLastSnapshotInfo", ColSnapshots, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"doc_id": docID,
+			"server_seq": bson.M{
+				"$lte": serverSeq,
+			},
+		}, options.FindOne().SetSort(bson.M{"server_seq": -1}))
+
+		if err := result.Decode(&snapshotInfo); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &snapshotInfo, nil
+}
+
+// TryLock acquires the lock of the given name for the given owner until the
+// given TTL passes. It returns false if the lock is held by another owner.
+// The owner holding the lock can extend it by acquiring it again.
+func (c *Client) TryLock(
+	ctx context.Context,
+	name string,
+	owner string,
+	ttl time.Duration,
+) (bool, error) {
+	locked := false
+	if err := c.withCollection(ctx, "TryLock", ColLocks, func(col *mongo.Collection) error {
+		now := time.Now()
+		_, err := col.UpdateOne(ctx, bson.M{
+			"_id": name,
+			"$or": bson.A{
+				bson.M{"owner": owner},
+				bson.M{"expires_at": bson.M{"$lt": now}},
+			},
+		}, bson.M{
+			"$set": bson.M{
+				"owner":      owner,
+				"expires_at": now.Add(ttl),
+			},
+		}, options.Update().SetUpsert(true))
+		if err != nil {
+			if isDuplicateKeyError(err) {
+				return nil
+			}
+			log.Logger.Error(err)
+			return err
+		}
+
+		locked = true
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
+
+// EnsureIndexes verifies the indexes of the collections and creates the
+// missing ones.
+func (c *Client) EnsureIndexes(ctx context.Context) error {
+	return ensureIndex(ctx, c.client.Database(c.config.YorkieDatabase))
+}
+
+func isDuplicateKeyError(err error) bool {
+	const duplicateKeyCode = 11000
+
+	switch err := err.(type) {
+	case mongo.WriteException:
+		for _, writeErr := range err.WriteErrors {
+			if writeErr.Code == duplicateKeyCode {
+				return true
+			}
+		}
+	case mongo.CommandError:
+		return err.Code == duplicateKeyCode
+	}
+
+	return false
+}
+
 func (c *Client) withCollection(
+	ctx context.Context,
+	name string,
 	collection string,
 	callback func(collection *mongo.Collection) error,
 ) error {
+	_, span := trace.Start(ctx, "mongo."+name, trace.Collection.String(collection))
+
 	col := c.client.Database(c.config.YorkieDatabase).Collection(collection)
 	if err := callback(col); err != nil {
+		trace.End(span, err)
 		return err
 	}
 
+	trace.End(span, nil)
 	return nil
 }
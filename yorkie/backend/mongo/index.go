@@ -18,6 +18,10 @@ var (
 	}}
 
 	ColDocInfos = "documents"
+	// NOTE: the index on the key also serves listing documents by the prefix
+	// of the key, since the key starts with the collection. The listing is an
+	// anchored, case-sensitive regex on the key with a lower bound, sorted by
+	// the key, so MongoDB scans only the range of the prefix in this index.
 	idxDocInfos = []mongo.IndexModel{{
 		Keys:    bsonx.Doc{{Key: "key", Value: bsonx.Int32(1)}},
 		Options: options.Index().SetUnique(true),
@@ -31,6 +35,17 @@ var (
 		},
 		Options: options.Index().SetUnique(true),
 	}}
+
+	ColSnapshots = "snapshots"
+	idxSnapshots = []mongo.IndexModel{{
+		Keys: bsonx.Doc{
+			{Key: "doc_id", Value: bsonx.Int32(1)},
+			{Key: "server_seq", Value: bsonx.Int32(1)},
+		},
+		Options: options.Index().SetUnique(true),
+	}}
+
+	ColLocks = "locks"
 )
 
 func ensureIndex(ctx context.Context, db *mongo.Database) error {
@@ -58,5 +73,13 @@ func ensureIndex(ctx context.Context, db *mongo.Database) error {
 		return err
 	}
 
+	if _, err := db.Collection(ColSnapshots).Indexes().CreateMany(
+		ctx,
+		idxSnapshots,
+	); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
 	return nil
 }
@@ -2,9 +2,13 @@ package clients
 
 import (
 	"context"
+	"time"
 
 	"github.com/hackerwins/yorkie/pkg/document/change"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/log"
 	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/documents"
 	"github.com/hackerwins/yorkie/yorkie/types"
 )
 
@@ -24,21 +28,183 @@ func Deactivate(
 	return be.Mongo.DeactivateClient(ctx, clientID)
 }
 
+// Renew extends the lease of the given client.
+func Renew(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID string,
+) (*types.ClientInfo, error) {
+	return be.Mongo.RenewClient(ctx, clientID)
+}
+
+// DeactivateStale deactivates the clients which have not renewed their
+// leases within the given TTL and detaches their documents. It returns the
+// deactivated clients.
+func DeactivateStale(
+	ctx context.Context,
+	be *backend.Backend,
+	ttl time.Duration,
+	limit int64,
+) ([]*types.ClientInfo, error) {
+	clientInfos, err := be.Mongo.FindStaleClientInfos(ctx, time.Now().Add(-ttl), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, clientInfo := range clientInfos {
+		clientInfo.Deactivate()
+		if err := be.Mongo.UpdateClientInfo(ctx, clientInfo); err != nil {
+			return nil, err
+		}
+		log.Logger.Infof("deactivate stale client: %s", clientInfo.ID.Hex())
+	}
+
+	return clientInfos, nil
+}
+
+// ForceDeactivate deactivates the given client after detaching all the
+// documents attached to it.
+func ForceDeactivate(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID string,
+) (*types.ClientInfo, error) {
+	clientInfo, err := be.Mongo.FindClientInfoByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientInfo.Deactivate()
+	if err := be.Mongo.UpdateClientInfo(ctx, clientInfo); err != nil {
+		return nil, err
+	}
+
+	return clientInfo, nil
+}
+
+// ForceDetachDocument detaches the given document from the given client
+// without the participation of the client.
+func ForceDetachDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID string,
+	docKey *key.Key,
+) (*types.ClientInfo, error) {
+	clientInfo, err := be.Mongo.FindClientInfoByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, clientInfo, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := clientInfo.ForceDetachDocument(docInfo.ID); err != nil {
+		return nil, err
+	}
+	if err := be.Mongo.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
+		return nil, err
+	}
+
+	return clientInfo, nil
+}
+
+func Find(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID string,
+) (*types.ClientInfo, error) {
+	return be.Mongo.FindClientInfoByID(ctx, clientID)
+}
+
+func List(
+	ctx context.Context,
+	be *backend.Backend,
+	limit int64,
+) ([]*types.ClientInfo, error) {
+	return be.Mongo.ListClientInfos(ctx, limit)
+}
+
+// FindClientAndDocument finds the client and the document of the given pack.
+// If createDocIfNotExist is true, the document is created when it does not
+// exist. It returns types.ErrDocumentRemoved if the document is removed.
 func FindClientAndDocument(
 	ctx context.Context,
 	be *backend.Backend,
 	clientID string,
 	pack *change.Pack,
+	createDocIfNotExist bool,
 ) (*types.ClientInfo, *types.DocInfo, error) {
 	clientInfo, err := be.Mongo.FindClientInfoByID(ctx, clientID)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, clientInfo, pack.DocumentKey.BSONKey())
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, clientInfo, pack.DocumentKey.BSONKey(), createDocIfNotExist)
 	if err != nil {
 		return nil, nil, err
 	}
+	if docInfo.IsRemoved() {
+		log.Logger.Error(types.ErrDocumentRemoved)
+		return nil, nil, types.ErrDocumentRemoved
+	}
 
 	return clientInfo, docInfo, nil
 }
+
+// FindClientAndCreateDocument finds the client and creates the document of
+// the given pack. It returns mongo.ErrDocumentAlreadyExists if the document
+// exists.
+func FindClientAndCreateDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID string,
+	pack *change.Pack,
+) (*types.ClientInfo, *types.DocInfo, error) {
+	clientInfo, err := be.Mongo.FindClientInfoByID(ctx, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docInfo, err := be.Mongo.CreateDocInfo(ctx, clientInfo, pack.DocumentKey.BSONKey())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clientInfo, docInfo, nil
+}
+
+// RemoveDocument removes the document of the given key on behalf of the
+// given client. The document is detached from the client if it is attached.
+func RemoveDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID string,
+	docKey *key.Key,
+) (*types.DocInfo, error) {
+	clientInfo, err := be.Mongo.FindClientInfoByID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if clientInfo.Status != types.ClientActivated {
+		log.Logger.Error(types.ErrClientNotActivated)
+		return nil, types.ErrClientNotActivated
+	}
+
+	docInfo, err := documents.Remove(ctx, be, docKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientInfo.IsAttached(docInfo.ID) {
+		if err := clientInfo.DetachDocument(docInfo.ID, clientInfo.GetCheckpoint(docInfo.ID)); err != nil {
+			return nil, err
+		}
+		if err := be.Mongo.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	return docInfo, nil
+}
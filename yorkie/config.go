@@ -6,12 +6,28 @@ import (
 	"os"
 
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
 	"github.com/hackerwins/yorkie/yorkie/backend/mongo"
+	"github.com/hackerwins/yorkie/yorkie/housekeeping"
 )
 
 type Config struct {
 	RPCPort int
 	Mongo   *mongo.Config
+	Trace   *trace.Config
+
+	// Housekeeping is the configuration for the background jobs of the agent.
+	// If it is nil, the housekeeping is disabled.
+	Housekeeping *housekeeping.Config
+
+	// AdminToken is the credential required to call the Admin service. If it
+	// is empty, the Admin service is disabled.
+	AdminToken string
+
+	// Schemas are the JSON-Schema-like schemas that the documents should
+	// conform to, keyed by the collection or the key of the document in
+	// "collection$document" form. The changes violating them are rejected.
+	Schemas map[string]json.RawMessage
 }
 
 func NewConfig(path string) (*Config, error) {
@@ -0,0 +1,479 @@
+package documents
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hackerwins/yorkie/pkg/document"
+	"github.com/hackerwins/yorkie/pkg/document/change"
+	"github.com/hackerwins/yorkie/pkg/document/checkpoint"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/document/schema"
+	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/types"
+)
+
+var (
+	ErrInvalidServerSeqRange = errors.New("invalid serverSeq range")
+	ErrChangesCompacted      = errors.New("changes already compacted")
+)
+
+// Find returns the document of the given key without creating it.
+func Find(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+) (*types.DocInfo, error) {
+	return be.Mongo.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+}
+
+// FindByID returns the document of the given ID.
+func FindByID(
+	ctx context.Context,
+	be *backend.Backend,
+	docID string,
+) (*types.DocInfo, error) {
+	return be.Mongo.FindDocInfoByID(ctx, docID)
+}
+
+// List returns the documents in the order of creation.
+func List(
+	ctx context.Context,
+	be *backend.Backend,
+	limit int64,
+) ([]*types.DocInfo, error) {
+	return be.Mongo.ListDocInfos(ctx, limit)
+}
+
+// ListByPrefix returns the documents of the given collection whose name starts
+// with the given prefix in the order of the name. If after is not empty, only
+// the documents whose name is after it are returned to page through the
+// collection.
+func ListByPrefix(
+	ctx context.Context,
+	be *backend.Backend,
+	collection string,
+	prefix string,
+	after string,
+	limit int64,
+) ([]*types.DocInfo, error) {
+	keyPrefix := &key.Key{Collection: collection, Document: prefix}
+
+	var afterBSONKey string
+	if after != "" {
+		afterBSONKey = (&key.Key{Collection: collection, Document: after}).BSONKey()
+	}
+
+	return be.Mongo.FindDocInfosByKeyPrefix(ctx, keyPrefix.BSONKey(), afterBSONKey, limit)
+}
+
+// Remove marks the given document as removed. The removed document rejects
+// further changes and is purged later.
+func Remove(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+) (*types.DocInfo, error) {
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+	if docInfo.IsRemoved() {
+		log.Logger.Error(types.ErrDocumentRemoved)
+		return nil, types.ErrDocumentRemoved
+	}
+
+	if err := be.Mongo.RemoveDocInfo(ctx, docInfo.ID); err != nil {
+		return nil, err
+	}
+
+	return be.Mongo.FindDocInfoByID(ctx, docInfo.ID.Hex())
+}
+
+// Purge deletes the given document with its changes and snapshots and
+// detaches it from all the clients.
+func Purge(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+) error {
+	if err := be.Mongo.DeleteDocInfo(ctx, docInfo.ID); err != nil {
+		return err
+	}
+	if err := be.Mongo.DeleteChangeInfos(ctx, docInfo.ID); err != nil {
+		return err
+	}
+	if err := be.Mongo.DeleteSnapshotInfos(ctx, docInfo.ID); err != nil {
+		return err
+	}
+	be.TakeValidatedDocument(docInfo.ID.Hex())
+
+	return be.Mongo.RemoveDocumentFromClientInfos(ctx, docInfo.ID)
+}
+
+// FindChanges returns the changes of the given document whose serverSeq is
+// between from and to. If from is 0, it is regarded as the first serverSeq
+// retained after the compaction. If to is 0, it is regarded as the last
+// serverSeq of the document. If limit is greater than 0, at most limit changes are
+// returned from the beginning of the range.
+func FindChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	from uint64,
+	to uint64,
+	limit uint32,
+) ([]*change.Change, error) {
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if from == 0 {
+		from = docInfo.CompactedSeq + 1
+	}
+	if from <= docInfo.CompactedSeq {
+		log.Logger.Error(ErrChangesCompacted)
+		return nil, ErrChangesCompacted
+	}
+	if to == 0 || to > docInfo.ServerSeq {
+		to = docInfo.ServerSeq
+	}
+	if limit > 0 && to-from+1 > uint64(limit) {
+		to = from + uint64(limit) - 1
+	}
+
+	if from > to {
+		if from == docInfo.ServerSeq+1 {
+			return nil, nil
+		}
+
+		log.Logger.Error(ErrInvalidServerSeqRange)
+		return nil, ErrInvalidServerSeqRange
+	}
+
+	return be.Mongo.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, from, to)
+}
+
+// Materialize rebuilds the given document as it was at the given serverSeq
+// from the last snapshot and the changes after it. If serverSeq is 0 or
+// beyond the last change, the latest state of the document is rebuilt.
+func Materialize(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	serverSeq uint64,
+) (*document.Document, error) {
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if serverSeq == 0 || serverSeq > docInfo.ServerSeq {
+		serverSeq = docInfo.ServerSeq
+	}
+	if serverSeq < docInfo.CompactedSeq {
+		log.Logger.Error(ErrChangesCompacted)
+		return nil, ErrChangesCompacted
+	}
+
+	return materialize(ctx, be, docKey, docInfo, serverSeq)
+}
+
+// MaterializeAt rebuilds the given document as it was at the given time.
+func MaterializeAt(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	at time.Time,
+) (*document.Document, error) {
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	serverSeq, err := be.Mongo.FindLastServerSeqBefore(ctx, docInfo.ID, at)
+	if err != nil {
+		return nil, err
+	}
+	if serverSeq == 0 {
+		if docInfo.CompactedSeq > 0 && at.After(docInfo.CreatedAt) {
+			log.Logger.Error(ErrChangesCompacted)
+			return nil, ErrChangesCompacted
+		}
+		return document.New(docKey.Collection, docKey.Document), nil
+	}
+
+	return Materialize(ctx, be, docKey, serverSeq)
+}
+
+// Diff returns the diff of the given document from the given serverSeq to the
+// other one. fromSeq 0 means the empty document before the first change and
+// toSeq 0 means the latest state of the document.
+func Diff(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	fromSeq uint64,
+	toSeq uint64,
+) (*document.Diff, error) {
+	from := document.New(docKey.Collection, docKey.Document)
+	if fromSeq > 0 {
+		doc, err := Materialize(ctx, be, docKey, fromSeq)
+		if err != nil {
+			return nil, err
+		}
+		from = doc
+	}
+
+	to, err := Materialize(ctx, be, docKey, toSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return document.Compare(from, to), nil
+}
+
+// ValidateChanges returns schema.ViolationError if the given document would
+// not conform to the given schema after the given changes are applied on it
+// as it was at the given serverSeq. Otherwise, it returns the document with
+// the changes applied, which can be cached with CacheValidatedDocument once
+// the changes are stored.
+func ValidateChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	serverSeq uint64,
+	s *schema.Schema,
+	changes []*change.Change,
+) (*document.Document, error) {
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := validatedDocument(ctx, be, docKey, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.ApplyChangePack(change.NewPack(
+		docKey,
+		checkpoint.New(changes[len(changes)-1].ServerSeq(), 0),
+		changes,
+	)); err != nil {
+		return nil, err
+	}
+
+	doc.SetSchema(s)
+	if err := doc.ValidateSchema(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// validatedDocument returns the given document as it was at the given
+// serverSeq. It starts from the state cached by the last validation and
+// applies the changes stored after it, and rebuilds the document only if
+// there is no usable state in the cache.
+func validatedDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	docInfo *types.DocInfo,
+	serverSeq uint64,
+) (*document.Document, error) {
+	doc := be.TakeValidatedDocument(docInfo.ID.Hex())
+	if doc == nil ||
+		doc.Checkpoint().ServerSeq > serverSeq ||
+		doc.Checkpoint().ServerSeq < docInfo.CompactedSeq {
+		return materialize(ctx, be, docKey, docInfo, serverSeq)
+	}
+
+	cachedSeq := doc.Checkpoint().ServerSeq
+	if cachedSeq == serverSeq {
+		return doc, nil
+	}
+
+	changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+		ctx,
+		docInfo.ID,
+		cachedSeq+1,
+		serverSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.ApplyChangePack(change.NewPack(
+		docKey,
+		checkpoint.New(serverSeq, 0),
+		changes,
+	)); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// CreateSnapshot stores a snapshot of the given document if the number of
+// changes after the last snapshot reaches the given threshold. It returns
+// whether a snapshot is created or not.
+func CreateSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	threshold uint64,
+) (bool, error) {
+	snapshotInfo, err := be.Mongo.FindLastSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	if err != nil {
+		return false, err
+	}
+	if docInfo.ServerSeq-snapshotInfo.ServerSeq < threshold {
+		return false, nil
+	}
+
+	snapshot, err := BuildSnapshot(ctx, be, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return false, err
+	}
+
+	if err := be.Mongo.CreateSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, snapshot); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// BuildSnapshot returns the snapshot of the given document as it was at the
+// given serverSeq.
+func BuildSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	serverSeq uint64,
+) ([]byte, error) {
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := materialize(ctx, be, docKey, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.Snapshot()
+}
+
+// Compact deletes the changes of the given document which are no longer
+// needed and returns the serverSeq compacted up to. A change is deleted only
+// if a snapshot after it exists and every client attached to the document
+// has already pulled it. The changes stored within the retention period and
+// the last retentionCount changes before the last snapshot are retained. It
+// returns 0 if there is nothing to compact.
+func Compact(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	retentionPeriod time.Duration,
+	retentionCount uint64,
+) (uint64, error) {
+	lastSnapshotInfo, err := be.Mongo.FindLastSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq)
+	if err != nil {
+		return 0, err
+	}
+	if lastSnapshotInfo.ServerSeq <= retentionCount {
+		return 0, nil
+	}
+	bound := lastSnapshotInfo.ServerSeq - retentionCount
+
+	clientInfos, err := be.Mongo.FindAttachedClientInfos(ctx, docInfo.ID)
+	if err != nil {
+		return 0, err
+	}
+	for _, clientInfo := range clientInfos {
+		if cp := clientInfo.GetCheckpoint(docInfo.ID); cp.ServerSeq < bound {
+			bound = cp.ServerSeq
+		}
+	}
+
+	if retentionPeriod > 0 {
+		serverSeq, err := be.Mongo.FindLastServerSeqBefore(ctx, docInfo.ID, time.Now().Add(-retentionPeriod))
+		if err != nil {
+			return 0, err
+		}
+		if serverSeq < bound {
+			bound = serverSeq
+		}
+	}
+
+	// NOTE: the changes are compacted up to a snapshot so that the document
+	// can be materialized at any serverSeq after the compacted one.
+	snapshotInfo, err := be.Mongo.FindLastSnapshotInfo(ctx, docInfo.ID, bound)
+	if err != nil {
+		return 0, err
+	}
+	if snapshotInfo.ServerSeq <= docInfo.CompactedSeq {
+		return 0, nil
+	}
+
+	if err := be.Mongo.CompactChangeInfos(ctx, docInfo.ID, snapshotInfo.ServerSeq); err != nil {
+		return 0, err
+	}
+
+	return snapshotInfo.ServerSeq, nil
+}
+
+func materialize(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	docInfo *types.DocInfo,
+	serverSeq uint64,
+) (*document.Document, error) {
+	snapshotInfo, err := be.Mongo.FindLastSnapshotInfo(ctx, docInfo.ID, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document.New(docKey.Collection, docKey.Document)
+	if snapshotInfo.ServerSeq > 0 {
+		doc, err = document.NewFromSnapshot(
+			docKey.Collection,
+			docKey.Document,
+			snapshotInfo.ServerSeq,
+			snapshotInfo.Snapshot,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if serverSeq == snapshotInfo.ServerSeq {
+		return doc, nil
+	}
+
+	changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+		ctx,
+		docInfo.ID,
+		snapshotInfo.ServerSeq+1,
+		serverSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.ApplyChangePack(change.NewPack(
+		docKey,
+		checkpoint.New(serverSeq, 0),
+		changes,
+	)); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,155 @@
+package documents
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hackerwins/yorkie/api"
+	"github.com/hackerwins/yorkie/api/converter"
+	"github.com/hackerwins/yorkie/pkg/document/key"
+	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/types"
+)
+
+var (
+	ErrInvalidExport = errors.New("invalid document export")
+)
+
+// Export returns the given document in a portable form. If snapshotOnly is
+// true, only the snapshot of the latest state is exported. Otherwise, the
+// changes retained in the agent are exported with the snapshot taken at the
+// compacted serverSeq, if the changes have been compacted.
+func Export(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	snapshotOnly bool,
+) (*api.DocumentExport, error) {
+	docInfo, err := be.Mongo.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &api.DocumentExport{
+		DocumentKey: converter.ToDocumentKey(docKey),
+		ServerSeq:   docInfo.ServerSeq,
+	}
+
+	snapshotServerSeq := docInfo.CompactedSeq
+	if snapshotOnly {
+		snapshotServerSeq = docInfo.ServerSeq
+	}
+	if snapshotServerSeq > 0 {
+		snapshot, err := BuildSnapshot(ctx, be, docInfo, snapshotServerSeq)
+		if err != nil {
+			return nil, err
+		}
+
+		pbSnapshot := &api.Snapshot{}
+		if err := pbSnapshot.Unmarshal(snapshot); err != nil {
+			log.Logger.Error(err)
+			return nil, err
+		}
+		export.SnapshotServerSeq = snapshotServerSeq
+		export.Snapshot = pbSnapshot
+	}
+
+	if snapshotServerSeq < docInfo.ServerSeq {
+		changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+			ctx,
+			docInfo.ID,
+			snapshotServerSeq+1,
+			docInfo.ServerSeq,
+		)
+		if err != nil {
+			return nil, err
+		}
+		export.Changes = converter.ToChanges(changes)
+	}
+
+	return export, nil
+}
+
+// Import creates the document of the given export with its snapshot and
+// changes. It returns mongo.ErrDocumentAlreadyExists if the document exists.
+func Import(
+	ctx context.Context,
+	be *backend.Backend,
+	export *api.DocumentExport,
+) (*types.DocInfo, error) {
+	if err := validateExport(export); err != nil {
+		return nil, err
+	}
+
+	docKey := converter.FromDocumentKey(export.DocumentKey)
+	docInfo, err := be.Mongo.ImportDocInfo(
+		ctx,
+		docKey.BSONKey(),
+		export.ServerSeq,
+		export.SnapshotServerSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := importContents(ctx, be, docInfo, export); err != nil {
+		if err := Purge(ctx, be, docInfo); err != nil {
+			log.Logger.Error(err)
+		}
+		return nil, err
+	}
+
+	return docInfo, nil
+}
+
+func importContents(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	export *api.DocumentExport,
+) error {
+	if export.Snapshot != nil {
+		snapshot, err := export.Snapshot.Marshal()
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+		if err := be.Mongo.CreateSnapshotInfo(ctx, docInfo.ID, export.SnapshotServerSeq, snapshot); err != nil {
+			return err
+		}
+	}
+
+	return be.Mongo.CreateChangeInfos(ctx, docInfo.ID, converter.FromChanges(export.Changes))
+}
+
+// validateExport checks that the export has the snapshot if it is taken and
+// the changes after it without any gap.
+func validateExport(export *api.DocumentExport) error {
+	if export.DocumentKey == nil ||
+		export.DocumentKey.Collection == "" ||
+		export.DocumentKey.Document == "" {
+		log.Logger.Error(ErrInvalidExport)
+		return ErrInvalidExport
+	}
+
+	if (export.SnapshotServerSeq > 0) != (export.Snapshot != nil) {
+		log.Logger.Error(ErrInvalidExport)
+		return ErrInvalidExport
+	}
+
+	serverSeq := export.SnapshotServerSeq
+	for _, c := range export.Changes {
+		if c.ServerSeq != serverSeq+1 {
+			log.Logger.Error(ErrInvalidExport)
+			return ErrInvalidExport
+		}
+		serverSeq = c.ServerSeq
+	}
+	if serverSeq != export.ServerSeq {
+		log.Logger.Error(ErrInvalidExport)
+		return ErrInvalidExport
+	}
+
+	return nil
+}
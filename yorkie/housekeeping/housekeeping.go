@@ -0,0 +1,199 @@
+package housekeeping
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/yorkie/backend"
+)
+
+// Config is the configuration for the housekeeping. A job whose interval is 0
+// is disabled.
+type Config struct {
+	// ClientDeactivationIntervalSec is the interval in seconds of deactivating
+	// the clients that have not renewed their leases.
+	ClientDeactivationIntervalSec int `json:"ClientDeactivationIntervalSec"`
+
+	// ClientTTLSec is the time in seconds after which a client that has not
+	// renewed its lease is deactivated. 0 means that clients never expire.
+	ClientTTLSec int `json:"ClientTTLSec"`
+
+	// SnapshotIntervalSec is the interval in seconds of creating snapshots.
+	SnapshotIntervalSec int `json:"SnapshotIntervalSec"`
+
+	// SnapshotThreshold is the number of changes after the last snapshot
+	// which makes a new snapshot of a document.
+	SnapshotThreshold uint64 `json:"SnapshotThreshold"`
+
+	// ChangeCompactionIntervalSec is the interval in seconds of deleting the
+	// changes which are no longer needed.
+	ChangeCompactionIntervalSec int `json:"ChangeCompactionIntervalSec"`
+
+	// ChangeRetentionSec is the time in seconds during which changes are
+	// retained after they are stored. 0 means that changes are not retained
+	// by age.
+	ChangeRetentionSec int `json:"ChangeRetentionSec"`
+
+	// ChangeRetentionCount is the number of changes retained before the last
+	// snapshot of a document.
+	ChangeRetentionCount uint64 `json:"ChangeRetentionCount"`
+
+	// DocumentPurgeIntervalSec is the interval in seconds of purging the
+	// removed documents.
+	DocumentPurgeIntervalSec int `json:"DocumentPurgeIntervalSec"`
+
+	// DocumentPurgeDelaySec is the time in seconds after which a removed
+	// document is purged with its changes.
+	DocumentPurgeDelaySec int `json:"DocumentPurgeDelaySec"`
+
+	// IndexVerificationIntervalSec is the interval in seconds of verifying
+	// the indexes of MongoDB.
+	IndexVerificationIntervalSec int `json:"IndexVerificationIntervalSec"`
+
+	// CandidatesLimit is the maximum number of clients or documents handled
+	// by a job at a time.
+	CandidatesLimit int64 `json:"CandidatesLimit"`
+}
+
+// job is a periodic task of the housekeeping.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// Housekeeping runs periodic jobs which keep the backend clean in the
+// background.
+//
+// Several agents may share the same backend. To run each job on only one of
+// them at a time, an agent takes the lock of the job in MongoDB before running
+// it. The lock expires after the interval of the job, so another agent takes
+// over the job if the agent holding the lock stops.
+type Housekeeping struct {
+	conf    *Config
+	backend *backend.Backend
+	agentID string
+
+	jobs   []*job
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	// snapshotCheckedAt and snapshotCheckedID are the update time and the ID
+	// of the last document checked by the snapshot job.
+	snapshotCheckedAt time.Time
+	snapshotCheckedID primitive.ObjectID
+
+	// compactionCheckedID is the ID of the last document checked by the
+	// compaction job.
+	compactionCheckedID primitive.ObjectID
+}
+
+// New creates an instance of Housekeeping.
+func New(conf *Config, be *backend.Backend) *Housekeeping {
+	h := &Housekeeping{
+		conf:    conf,
+		backend: be,
+		agentID: uuid.New().String(),
+		stopCh:  make(chan struct{}),
+	}
+
+	// there are no stale clients to deactivate if clients never expire.
+	clientDeactivationInterval := seconds(conf.ClientDeactivationIntervalSec)
+	if conf.ClientTTLSec <= 0 {
+		clientDeactivationInterval = 0
+	}
+
+	h.jobs = []*job{{
+		name:     "deactivate-stale-clients",
+		interval: clientDeactivationInterval,
+		run:      h.deactivateStaleClients,
+	}, {
+		name:     "create-snapshots",
+		interval: seconds(conf.SnapshotIntervalSec),
+		run:      h.createSnapshots,
+	}, {
+		name:     "compact-changes",
+		interval: seconds(conf.ChangeCompactionIntervalSec),
+		run:      h.compactChanges,
+	}, {
+		name:     "purge-documents",
+		interval: seconds(conf.DocumentPurgeIntervalSec),
+		run:      h.purgeDocuments,
+	}, {
+		name:     "verify-indexes",
+		interval: seconds(conf.IndexVerificationIntervalSec),
+		run:      h.verifyIndexes,
+	}}
+
+	return h
+}
+
+// Start starts the jobs in the background.
+func (h *Housekeeping) Start() {
+	for _, j := range h.jobs {
+		if j.interval <= 0 {
+			continue
+		}
+
+		h.wg.Add(1)
+		go h.schedule(j)
+	}
+}
+
+// Stop stops the jobs and waits for the running ones to finish.
+func (h *Housekeeping) Stop() {
+	close(h.stopCh)
+	h.wg.Wait()
+}
+
+func (h *Housekeeping) schedule(j *job) {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.runJob(j)
+		case <-h.stopCh:
+			return
+		}
+	}
+}
+
+func (h *Housekeeping) runJob(j *job) {
+	// a job must not stop the agent even if it panics on a broken document.
+	defer func() {
+		if r := recover(); r != nil {
+			log.Logger.Errorf("housekeeping job %s panicked: %v", j.name, r)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	locked, err := h.backend.Mongo.TryLock(ctx, j.name, h.agentID, j.interval)
+	if err != nil {
+		log.Logger.Error(err)
+		return
+	}
+	if !locked {
+		return
+	}
+
+	if err := j.run(ctx); err != nil {
+		log.Logger.Errorf("housekeeping job %s: %s", j.name, err)
+	}
+}
+
+// seconds converts the given number of seconds in the configuration to a
+// duration.
+func seconds(sec int) time.Duration {
+	return time.Duration(sec) * time.Second
+}
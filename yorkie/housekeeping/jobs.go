@@ -0,0 +1,123 @@
+package housekeeping
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/yorkie/clients"
+	"github.com/hackerwins/yorkie/yorkie/documents"
+)
+
+// deactivateStaleClients deactivates the clients that have not renewed their
+// leases within the TTL.
+func (h *Housekeeping) deactivateStaleClients(ctx context.Context) error {
+	_, err := clients.DeactivateStale(
+		ctx,
+		h.backend,
+		seconds(h.conf.ClientTTLSec),
+		h.conf.CandidatesLimit,
+	)
+	return err
+}
+
+// createSnapshots creates snapshots of the documents updated since the last
+// run that have enough changes after their last snapshots.
+func (h *Housekeeping) createSnapshots(ctx context.Context) error {
+	docInfos, err := h.backend.Mongo.FindDocInfosUpdatedAfter(
+		ctx,
+		h.snapshotCheckedAt,
+		h.snapshotCheckedID,
+		h.conf.CandidatesLimit,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, docInfo := range docInfos {
+		h.snapshotCheckedAt = docInfo.UpdatedAt
+		h.snapshotCheckedID = docInfo.ID
+
+		// NOTE: a document that fails is skipped so that it does not block
+		// the snapshots of the others. It is checked again after its next
+		// update.
+		created, err := documents.CreateSnapshot(ctx, h.backend, docInfo, h.conf.SnapshotThreshold)
+		if err != nil {
+			log.Logger.Errorf("create snapshot: %s, %s", docInfo.Key, err)
+			continue
+		}
+		if created {
+			log.Logger.Infof("create snapshot: %s, serverSeq: %d", docInfo.Key, docInfo.ServerSeq)
+		}
+	}
+
+	return nil
+}
+
+// compactChanges deletes the changes which are no longer needed. The
+// documents are visited in the order of ID over several runs.
+func (h *Housekeeping) compactChanges(ctx context.Context) error {
+	docInfos, err := h.backend.Mongo.FindDocInfosAfterID(
+		ctx,
+		h.compactionCheckedID,
+		h.conf.CandidatesLimit,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, docInfo := range docInfos {
+		h.compactionCheckedID = docInfo.ID
+
+		compactedSeq, err := documents.Compact(
+			ctx,
+			h.backend,
+			docInfo,
+			seconds(h.conf.ChangeRetentionSec),
+			h.conf.ChangeRetentionCount,
+		)
+		if err != nil {
+			log.Logger.Errorf("compact changes: %s, %s", docInfo.Key, err)
+			continue
+		}
+		if compactedSeq > 0 {
+			log.Logger.Infof("compact changes: %s, serverSeq: %d", docInfo.Key, compactedSeq)
+		}
+	}
+
+	// start over from the first document in the next run.
+	if h.conf.CandidatesLimit <= 0 || int64(len(docInfos)) < h.conf.CandidatesLimit {
+		h.compactionCheckedID = primitive.NilObjectID
+	}
+
+	return nil
+}
+
+// purgeDocuments deletes the documents removed before the purge delay with
+// their changes.
+func (h *Housekeeping) purgeDocuments(ctx context.Context) error {
+	docInfos, err := h.backend.Mongo.FindRemovedDocInfos(
+		ctx,
+		time.Now().Add(-seconds(h.conf.DocumentPurgeDelaySec)),
+		h.conf.CandidatesLimit,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, docInfo := range docInfos {
+		if err := documents.Purge(ctx, h.backend, docInfo); err != nil {
+			return err
+		}
+		log.Logger.Infof("purge document: %s", docInfo.Key)
+	}
+
+	return nil
+}
+
+// verifyIndexes creates the indexes of MongoDB that are missing.
+func (h *Housekeeping) verifyIndexes(ctx context.Context) error {
+	return h.backend.Mongo.EnsureIndexes(ctx)
+}
@@ -3,24 +3,43 @@ package packs
 import (
 	"context"
 
+	"github.com/hackerwins/yorkie/pkg/document"
 	"github.com/hackerwins/yorkie/pkg/document/change"
 	"github.com/hackerwins/yorkie/pkg/document/checkpoint"
 	"github.com/hackerwins/yorkie/pkg/document/key"
 	"github.com/hackerwins/yorkie/pkg/log"
+	"github.com/hackerwins/yorkie/pkg/trace"
 	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/documents"
 	"github.com/hackerwins/yorkie/yorkie/types"
 )
 
+// PushPull stores the changes of the given pack and returns the changes that
+// the client has not pulled yet. If pushOnly is true, the changes are only
+// pushed and the serverSeq of the client's checkpoint is kept so that the
+// remote changes can be pulled later.
 func PushPull(
 	ctx context.Context,
 	be *backend.Backend,
 	clientInfo *types.ClientInfo,
 	docInfo *types.DocInfo,
 	pack *change.Pack,
-) (*change.Pack, error) {
+	pushOnly bool,
+) (pulled *change.Pack, err error) {
 	// TODO Changes may be reordered or missing during communication on the network.
 	// We should check the change.pack with checkpoint to make sure the changes are in the correct order.
 
+	ctx, span := trace.Start(
+		ctx,
+		"packs.PushPull",
+		trace.ClientID.String(clientInfo.ID.Hex()),
+		trace.DocumentKey.String(docInfo.Key),
+		trace.ChangeCount.Int(len(pack.Changes)),
+	)
+	defer func() {
+		trace.End(span, err)
+	}()
+
 	initialServerSeq := docInfo.ServerSeq
 
 	// 01. push changes
@@ -29,12 +48,43 @@ func PushPull(
 		return nil, err
 	}
 
-	// 02. pull changes
-	pulledCP, pulledChanges, err := pullChanges(ctx, be, clientInfo, docInfo, pack, pushedCP, initialServerSeq)
+	validated, err := validateChanges(ctx, be, docInfo, pack, pushedChanges, initialServerSeq)
 	if err != nil {
 		return nil, err
 	}
 
+	// 02. pull changes
+	var pulledCP *checkpoint.Checkpoint
+	var pulledChanges []*change.Change
+	var snapshot []byte
+	if pushOnly {
+		pulledCP = checkpoint.New(
+			clientInfo.GetCheckpoint(docInfo.ID).ServerSeq,
+			pushedCP.ClientSeq,
+		)
+	} else {
+		pulledCP, pulledChanges, snapshot, err = pullChanges(
+			ctx,
+			be,
+			clientInfo,
+			docInfo,
+			pack,
+			pushedCP,
+			pushedChanges,
+			initialServerSeq,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(
+		trace.PushedChangeCount.Int(len(pushedChanges)),
+		trace.PulledChangeCount.Int(len(pulledChanges)),
+		trace.ServerSeqFrom.Int64(int64(initialServerSeq)),
+		trace.ServerSeqTo.Int64(int64(docInfo.ServerSeq)),
+	)
+
 	if err := clientInfo.UpdateCheckpoint(docInfo.ID, pulledCP); err != nil {
 		return nil, err
 	}
@@ -52,16 +102,22 @@ func PushPull(
 		return nil, err
 	}
 
+	if validated != nil {
+		be.CacheValidatedDocument(docInfo.ID.Hex(), validated)
+	}
+
 	docKey, err := key.FromBSONKey(docInfo.Key)
 	if err != nil {
 		return nil, err
 	}
 
-	return change.NewPack(
+	pulled = change.NewPack(
 		docKey,
 		pulledCP,
 		pulledChanges,
-	), nil
+	)
+	pulled.Snapshot = snapshot
+	return pulled, nil
 }
 
 func pushChanges(
@@ -72,6 +128,11 @@ func pushChanges(
 ) (*checkpoint.Checkpoint, []*change.Change, error) {
 	cp := clientInfo.GetCheckpoint(docInfo.ID)
 
+	if len(pack.Changes) > 0 && clientInfo.IsReadOnly(docInfo.ID) {
+		log.Logger.Error(types.ErrDocumentReadOnly)
+		return nil, nil, types.ErrDocumentReadOnly
+	}
+
 	var pushedChanges []*change.Change
 	for _, c := range pack.Changes {
 		if c.ID().ClientSeq() > cp.ClientSeq {
@@ -102,6 +163,34 @@ func pushChanges(
 	return cp, pushedChanges, nil
 }
 
+// validateChanges validates the document after the given pushed changes
+// against its schema, if any. It returns the validated document to cache
+// after the changes are stored, or nil if there is nothing to validate.
+func validateChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	pack *change.Pack,
+	pushedChanges []*change.Change,
+	initialServerSeq uint64,
+) (*document.Document, error) {
+	s := be.FindSchema(pack.DocumentKey)
+	if s == nil || len(pushedChanges) == 0 {
+		return nil, nil
+	}
+
+	doc, err := documents.ValidateChanges(ctx, be, docInfo, initialServerSeq, s, pushedChanges)
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// pullChanges returns the changes that the client has not pulled yet. If some
+// of them have been compacted, it returns the snapshot of the document before
+// the pushed changes and the pushed changes to apply on it instead.
 func pullChanges(
 	ctx context.Context,
 	be *backend.Backend,
@@ -109,19 +198,48 @@ func pullChanges(
 	docInfo *types.DocInfo,
 	pack *change.Pack,
 	pushedCP *checkpoint.Checkpoint,
+	pushedChanges []*change.Change,
 	initialServerSeq uint64,
-) (*checkpoint.Checkpoint, []*change.Change, error) {
-	pulledChanges, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+) (*checkpoint.Checkpoint, []*change.Change, []byte, error) {
+	pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
+
+	if pack.Checkpoint.ServerSeq < docInfo.CompactedSeq {
+		snapshot, err := documents.BuildSnapshot(ctx, be, docInfo, initialServerSeq)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		log.Logger.Infof(
+			"PULL: '%s' pulls snapshot(%d) from '%s', cp: %s",
+			clientInfo.ID.Hex(),
+			initialServerSeq,
+			docInfo.Key,
+			pulledCP.String(),
+		)
+
+		return pulledCP, pushedChanges, snapshot, nil
+	}
+
+	changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		pack.Checkpoint.ServerSeq+1,
 		initialServerSeq,
 	)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
+	// NOTE: the changes pushed by the client without pulling are already in
+	// the client's document, so they are not sent back.
+	var pulledChanges []*change.Change
+	for _, c := range changes {
+		if c.ID().Actor().String() == clientInfo.ID.Hex() &&
+			c.ClientSeq() <= pack.Checkpoint.ClientSeq {
+			continue
+		}
+		pulledChanges = append(pulledChanges, c)
+	}
 
 	if len(pulledChanges) > 0 {
 		log.Logger.Infof(
@@ -135,5 +253,5 @@ func pullChanges(
 		)
 	}
 
-	return pulledCP, pulledChanges, nil
+	return pulledCP, pulledChanges, nil, nil
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	time2 "time"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/hackerwins/yorkie/api"
@@ -19,6 +21,7 @@ type ChangeInfo struct {
 	Actor      primitive.ObjectID `bson:"actor"`
 	Message    string             `bson:"message"`
 	Operations [][]byte           `bson:"operations"`
+	CreatedAt  time2.Time         `bson:"created_at"`
 }
 
 func EncodeOperation(operations []operation.Operation) [][]byte {
@@ -14,6 +14,7 @@ var (
 	ErrClientNotActivated      = errors.New("client not activated")
 	ErrDocumentNotAttached     = errors.New("document not attached")
 	ErrDocumentAlreadyAttached = errors.New("document already attached")
+	ErrDocumentReadOnly        = errors.New("document attached as read-only")
 )
 
 const (
@@ -30,6 +31,7 @@ type ClientDocInfo struct {
 	Status    string `bson:"status"`
 	ServerSeq uint64 `bson:"server_seq"`
 	ClientSeq uint32 `bson:"client_Seq"`
+	ReadOnly  bool   `bson:"read_only"`
 }
 
 type ClientInfo struct {
@@ -41,7 +43,13 @@ type ClientInfo struct {
 	UpdatedAt time.Time                 `bson:"updated_at"`
 }
 
-func (i *ClientInfo) AttachDocument(docID primitive.ObjectID, cp *checkpoint.Checkpoint) error {
+// AttachDocument attaches the given document to this client. If readOnly is
+// true, the client can not push changes of the document.
+func (i *ClientInfo) AttachDocument(
+	docID primitive.ObjectID,
+	cp *checkpoint.Checkpoint,
+	readOnly bool,
+) error {
 	if i.Status != ClientActivated {
 		log.Logger.Error(ErrClientNotActivated)
 		return ErrClientNotActivated
@@ -53,7 +61,7 @@ func (i *ClientInfo) AttachDocument(docID primitive.ObjectID, cp *checkpoint.Che
 
 	hexDocID := docID.Hex()
 
-	if _, ok := i.Documents[hexDocID]; ok {
+	if i.IsAttached(docID) {
 		log.Logger.Error(ErrDocumentAlreadyAttached)
 		return ErrDocumentAlreadyAttached
 	}
@@ -62,6 +70,7 @@ func (i *ClientInfo) AttachDocument(docID primitive.ObjectID, cp *checkpoint.Che
 		Status:    DocumentAttached,
 		ServerSeq: 0,
 		ClientSeq: 0,
+		ReadOnly:  readOnly,
 	}
 	i.UpdatedAt = time.Now()
 
@@ -80,6 +89,46 @@ func (i *ClientInfo) DetachDocument(docID primitive.ObjectID, cp *checkpoint.Che
 	return nil
 }
 
+// IsAttached returns whether the given document is attached to this client.
+func (i *ClientInfo) IsAttached(docID primitive.ObjectID) bool {
+	clientDocInfo, ok := i.Documents[docID.Hex()]
+	return ok && clientDocInfo.Status == DocumentAttached
+}
+
+// IsReadOnly returns whether the given document is attached to this client as
+// read-only.
+func (i *ClientInfo) IsReadOnly(docID primitive.ObjectID) bool {
+	clientDocInfo, ok := i.Documents[docID.Hex()]
+	return ok && clientDocInfo.ReadOnly
+}
+
+// ForceDetachDocument detaches the given document regardless of the status
+// of this client.
+func (i *ClientInfo) ForceDetachDocument(docID primitive.ObjectID) error {
+	hexDocID := docID.Hex()
+	if i.Documents == nil ||
+		i.Documents[hexDocID] == nil ||
+		i.Documents[hexDocID].Status == DocumentDetached {
+		log.Logger.Error(ErrDocumentNotAttached)
+		return ErrDocumentNotAttached
+	}
+
+	i.Documents[hexDocID].Status = DocumentDetached
+	i.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Deactivate deactivates this client and detaches all the documents attached
+// to it.
+func (i *ClientInfo) Deactivate() {
+	for _, docInfo := range i.Documents {
+		docInfo.Status = DocumentDetached
+	}
+	i.Status = ClientDeactivated
+	i.UpdatedAt = time.Now()
+}
+
 func (i *ClientInfo) GetCheckpoint(id primitive.ObjectID) *checkpoint.Checkpoint {
 	clientDocInfo := i.Documents[id.Hex()]
 	if clientDocInfo == nil {
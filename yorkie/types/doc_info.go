@@ -1,11 +1,16 @@
 package types
 
 import (
+	"errors"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+var (
+	ErrDocumentRemoved = errors.New("document removed")
+)
+
 type DocInfo struct {
 	ID         primitive.ObjectID `bson:"_id"`
 	Key        string             `bson:"key"`
@@ -14,9 +19,22 @@ type DocInfo struct {
 	CreatedAt  time.Time          `bson:"created_at"`
 	AccessedAt time.Time          `bson:"accessed_at"`
 	UpdatedAt  time.Time          `bson:"updated_at"`
+
+	// CompactedSeq is the serverSeq of the last change deleted by the
+	// compaction. The changes after it are retained.
+	CompactedSeq uint64 `bson:"compacted_seq"`
+
+	// RemovedAt is the time when the document was removed. It is zero if the
+	// document is not removed.
+	RemovedAt time.Time `bson:"removed_at"`
 }
 
 func (info *DocInfo) IncreaseServerSeq() uint64 {
 	info.ServerSeq++
 	return info.ServerSeq
 }
+
+// IsRemoved returns whether the document is removed or not.
+func (info *DocInfo) IsRemoved() bool {
+	return !info.RemovedAt.IsZero()
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	pbtypes "github.com/gogo/protobuf/types"
+
+	"github.com/hackerwins/yorkie/api"
+)
+
+// ToPB converts the given client to Protobuf format.
+func (i *ClientInfo) ToPB() (*api.ClientInfo, error) {
+	createdAt, err := pbtypes.TimestampProto(i.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := pbtypes.TimestampProto(i.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDocuments := make(map[string]*api.ClientDocumentInfo)
+	for docID, docInfo := range i.Documents {
+		pbDocuments[docID] = &api.ClientDocumentInfo{
+			Status:    docInfo.Status,
+			ServerSeq: docInfo.ServerSeq,
+			ClientSeq: docInfo.ClientSeq,
+		}
+	}
+
+	return &api.ClientInfo{
+		Id:        i.ID.Hex(),
+		Key:       i.Key,
+		Status:    i.Status,
+		Documents: pbDocuments,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// ToPB converts the given document to Protobuf format.
+func (info *DocInfo) ToPB() (*api.DocumentInfo, error) {
+	createdAt, err := pbtypes.TimestampProto(info.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	accessedAt, err := pbtypes.TimestampProto(info.AccessedAt)
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := pbtypes.TimestampProto(info.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	var removedAt *pbtypes.Timestamp
+	if info.IsRemoved() {
+		if removedAt, err = pbtypes.TimestampProto(info.RemovedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.DocumentInfo{
+		Id:         info.ID.Hex(),
+		Key:        info.Key,
+		ServerSeq:  info.ServerSeq,
+		Owner:      info.Owner.Hex(),
+		CreatedAt:  createdAt,
+		AccessedAt: accessedAt,
+		UpdatedAt:  updatedAt,
+		RemovedAt:  removedAt,
+	}, nil
+}
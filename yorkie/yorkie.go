@@ -1,37 +1,66 @@
 package yorkie
 
 import (
+	"context"
 	"sync"
 
+	"github.com/hackerwins/yorkie/pkg/document/schema"
+	"github.com/hackerwins/yorkie/pkg/trace"
 	"github.com/hackerwins/yorkie/yorkie/api"
 	"github.com/hackerwins/yorkie/yorkie/backend"
+	"github.com/hackerwins/yorkie/yorkie/housekeeping"
 )
 
 type Yorkie struct {
 	lock sync.Mutex
 
-	backend   *backend.Backend
-	rpcServer *api.RPCServer
+	backend       *backend.Backend
+	rpcServer     *api.RPCServer
+	housekeeping  *housekeeping.Housekeeping
+	traceShutdown func(ctx context.Context) error
 
 	shutdown   bool
 	shutdownCh chan struct{}
 }
 
 func New(conf *Config) (*Yorkie, error) {
+	var traceShutdown func(ctx context.Context) error
+	if conf.Trace != nil {
+		shutdown, err := trace.Init(conf.Trace)
+		if err != nil {
+			return nil, err
+		}
+		traceShutdown = shutdown
+	}
+
 	be, err := backend.New(conf.Mongo)
 	if err != nil {
 		return nil, err
 	}
+	for k, data := range conf.Schemas {
+		s, err := schema.Parse(data)
+		if err != nil {
+			return nil, err
+		}
+		be.Schemas[k] = s
+	}
 
-	rpcServer, err := api.NewRPCServer(conf.RPCPort, be)
+	rpcServer, err := api.NewRPCServer(conf.RPCPort, conf.AdminToken, be)
 	if err != nil {
 		return nil, err
 	}
 
+	var hk *housekeeping.Housekeeping
+	if conf.Housekeeping != nil {
+		hk = housekeeping.New(conf.Housekeeping, be)
+	}
+
 	return &Yorkie{
-		backend:    be,
-		rpcServer:  rpcServer,
-		shutdownCh: make(chan struct{}),
+		backend:       be,
+		rpcServer:     rpcServer,
+		housekeeping:  hk,
+		traceShutdown: traceShutdown,
+		shutdownCh:    make(chan struct{}),
 	}, nil
 }
 
@@ -39,6 +68,10 @@ func (r *Yorkie) Start() error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	if r.housekeeping != nil {
+		r.housekeeping.Start()
+	}
+
 	return r.rpcServer.Start()
 }
 
@@ -49,12 +82,22 @@ func (r *Yorkie) Shutdown(graceful bool) error {
 		return nil
 	}
 
+	if r.housekeeping != nil {
+		r.housekeeping.Stop()
+	}
+
 	if err := r.backend.Close(); err != nil {
 		return err
 	}
 
 	r.rpcServer.Shutdown(graceful)
 
+	if r.traceShutdown != nil {
+		if err := r.traceShutdown(context.Background()); err != nil {
+			return err
+		}
+	}
+
 	close(r.shutdownCh)
 	r.shutdown = true
 	return nil